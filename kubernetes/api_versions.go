@@ -19,9 +19,13 @@ const (
 	appsV1
 	appsV1beta1
 	appsV1beta2
+	autoscalingV1
+	autoscalingV2beta1
 	batchV1beta1
 	batchV2alpha1
+	eventsV1beta1
 	extensionsV1beta1
+	policyV1beta1
 )
 
 func (g APIGroup) String() string {
@@ -32,12 +36,20 @@ func (g APIGroup) String() string {
 		return "apps/v1beta1"
 	case appsV1beta2:
 		return "apps/v1beta2"
+	case autoscalingV1:
+		return "autoscaling/v1"
+	case autoscalingV2beta1:
+		return "autoscaling/v2beta1"
 	case extensionsV1beta1:
 		return "extensions/v1beta1"
 	case batchV1beta1:
 		return "batch/v1beta1"
 	case batchV2alpha1:
 		return "batch/v2alpha1"
+	case eventsV1beta1:
+		return "events.k8s.io/v1beta1"
+	case policyV1beta1:
+		return "policy/v1beta1"
 	default:
 		return "none"
 	}
@@ -0,0 +1,37 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// testDataSourceSchemaIsComputed checks that every attribute of a generated
+// data source schema is computed, except the given lookup keys which must be
+// required. Keys are given as paths, e.g. "metadata.0.name".
+func testDataSourceSchemaIsComputed(t *testing.T, s map[string]*schema.Schema, lookupKeys ...string) {
+	lookup := make(map[string]bool, len(lookupKeys))
+	for _, k := range lookupKeys {
+		lookup[k] = true
+	}
+	testDataSourceSchemaIsComputedWithPrefix(t, s, "", lookup)
+}
+
+func testDataSourceSchemaIsComputedWithPrefix(t *testing.T, s map[string]*schema.Schema, prefix string, lookup map[string]bool) {
+	for k, v := range s {
+		path := prefix + k
+		if lookup[path] {
+			if !v.Required || v.Computed || v.Optional {
+				t.Errorf("%s: expected lookup key to be required and not computed", path)
+			}
+		} else if !v.Computed || v.Required || v.Optional {
+			t.Errorf("%s: expected attribute to be computed only", path)
+		}
+		if v.ForceNew {
+			t.Errorf("%s: expected attribute not to force a new resource", path)
+		}
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			testDataSourceSchemaIsComputedWithPrefix(t, elem.Schema, path+".0.", lookup)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesConfigMaps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesConfigMapsRead,
+
+		Schema: objectListSchema("config map", true),
+	}
+}
+
+func dataSourceKubernetesConfigMapsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace := d.Get("namespace").(string)
+	list := func(opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		out, err := conn.CoreV1().ConfigMaps(namespace).List(opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]metav1.ObjectMeta, len(out.Items), len(out.Items))
+		for i, v := range out.Items {
+			items[i] = v.ObjectMeta
+		}
+		return items, out.Continue, nil
+	}
+
+	return readObjectList(d, list, d.Get("field_selector").(string), "namespace", "label_selector", "field_selector")
+}
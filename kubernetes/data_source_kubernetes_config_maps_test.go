@@ -0,0 +1,51 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceConfigMaps_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceConfigMapsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_config_maps.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_config_maps.test", "items.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceConfigMapsConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_config_map" "test" {
+	count = 3
+	metadata {
+		name = "%s-${count.index}"
+		labels {
+			tf-acc-test = "%s"
+		}
+	}
+	data {
+		index = "${count.index}"
+	}
+}
+
+data "kubernetes_config_maps" "test" {
+	namespace = "default"
+	label_selector = "tf-acc-test=${kubernetes_config_map.test.0.metadata.0.labels.tf-acc-test}"
+	field_selector = "metadata.name!=${kubernetes_config_map.test.2.metadata.0.name}"
+	depends_on = ["kubernetes_config_map.test"]
+}
+`, name, name)
+}
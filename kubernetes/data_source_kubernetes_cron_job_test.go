@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceKubernetesCronJobSchema(t *testing.T) {
+	testDataSourceSchemaIsComputed(t, dataSourceKubernetesCronJob().Schema, "metadata", "metadata.0.name", "metadata.0.namespace")
+}
+
+func TestAccKubernetesDataSourceCronJob_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceCronJobConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_cron_job.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_cron_job.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_cron_job.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_cron_job.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_cron_job.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_cron_job.test", "spec.0.schedule", "1 0 * * *"),
+					resource.TestCheckResourceAttr("data.kubernetes_cron_job.test", "spec.0.job_template.0.spec.0.backoff_limit", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceCronJobConfig_basic(name string) string {
+	return testAccKubernetesCronJobConfig_basic(name) + `
+data "kubernetes_cron_job" "test" {
+	metadata {
+		name = "${kubernetes_cron_job.test.metadata.0.name}"
+		namespace = "${kubernetes_cron_job.test.metadata.0.namespace}"
+	}
+}
+`
+}
@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceKubernetesDaemonSetSchema(t *testing.T) {
+	testDataSourceSchemaIsComputed(t, dataSourceKubernetesDaemonSet().Schema, "metadata", "metadata.0.name", "metadata.0.namespace")
+}
+
+func TestAccKubernetesDataSourceDaemonSet_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceDaemonSetConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_daemonset.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_daemonset.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_daemonset.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_daemonset.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_daemonset.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_daemonset.test", "spec.0.selector.0.match_labels.foo", "bar"),
+					resource.TestCheckResourceAttr("data.kubernetes_daemonset.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.7.8"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceDaemonSetConfig_basic(name string) string {
+	return testAccKubernetesDaemonSetConfig_minimal(name) + `
+data "kubernetes_daemonset" "test" {
+	metadata {
+		name = "${kubernetes_daemonset.test.metadata.0.name}"
+		namespace = "${kubernetes_daemonset.test.metadata.0.namespace}"
+	}
+}
+`
+}
@@ -0,0 +1,45 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceEndpoints_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceEndpointsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_endpoints.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_endpoints.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_endpoints.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_endpoints.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoints.test", "subset.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoints.test", "subset.0.address.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoints.test", "subset.0.address.0.ip", "10.0.0.4"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoints.test", "subset.0.port.0.name", "postgres"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoints.test", "subset.0.port.0.port", "5432"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceEndpointsConfig_basic(name string) string {
+	return testAccKubernetesEndpointsConfig_basic(name) + `
+data "kubernetes_endpoints" "test" {
+	metadata {
+		name      = "${kubernetes_endpoints.test.metadata.0.name}"
+		namespace = "${kubernetes_endpoints.test.metadata.0.namespace}"
+	}
+}
+`
+}
@@ -0,0 +1,175 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesEvents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesEventsRead,
+
+		Schema: map[string]*schema.Schema{
+			"involved_object": {
+				Type:        schema.TypeList,
+				Description: "The object the events are about.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the object, e.g. `Deployment`.",
+							Required:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the object.",
+							Required:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "Namespace of the object. Leave empty for cluster scoped objects, whose events are looked up in all namespaces.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "Only return events of this type, either `Normal` or `Warning`. Defaults to all events.",
+				Optional:     true,
+				ValidateFunc: validateAttributeValueIsIn([]string{api.EventTypeNormal, api.EventTypeWarning}),
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of events to return, starting with the latest. Events repeating the message of a later event are skipped.",
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validatePositiveInteger,
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Description: "The latest matching events.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the event, either `Normal` or `Warning`.",
+							Computed:    true,
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Description: "Short, machine understandable reason for the event, e.g. `FailedScheduling`.",
+							Computed:    true,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Description: "Human readable description of the event.",
+							Computed:    true,
+						},
+						"count": {
+							Type:        schema.TypeInt,
+							Description: "The number of times the event has occurred.",
+							Computed:    true,
+						},
+						"first_timestamp": {
+							Type:        schema.TypeString,
+							Description: "Time the event was first recorded, in RFC3339 format.",
+							Computed:    true,
+						},
+						"last_timestamp": {
+							Type:        schema.TypeString,
+							Description: "Time the event was most recently recorded, in RFC3339 format.",
+							Computed:    true,
+						},
+						"source_component": {
+							Type:        schema.TypeString,
+							Description: "Component reporting the event, e.g. `kubelet` or `deployment-controller`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesEventsRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	obj := d.Get("involved_object").([]interface{})[0].(map[string]interface{})
+	kind := obj["kind"].(string)
+	metadata := metav1.ObjectMeta{
+		Name:      obj["name"].(string),
+		Namespace: obj["namespace"].(string),
+	}
+	eventType := d.Get("type").(string)
+	limit := d.Get("limit").(int)
+
+	apiGroup, err := kp.highestSupportedAPIGroup("events", eventsV1beta1)
+	if err != nil {
+		return err
+	}
+
+	var events []api.Event
+	switch apiGroup {
+	case eventsV1beta1:
+		all, err := getEventsV1beta1ForObject(kp.conn, metadata, kind)
+		if err != nil {
+			return fmt.Errorf("Failed to list events: %s", err)
+		}
+		events = filterLastEvents(all, eventType, limit)
+	default:
+		events, err = getLastEventsForObject(kp.conn, metadata, kind, eventType, limit)
+		if err != nil {
+			return fmt.Errorf("Failed to list events: %s", err)
+		}
+	}
+
+	err = d.Set("events", flattenEvents(events))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join([]string{
+		kind, metadata.Namespace, metadata.Name, eventType, strconv.Itoa(limit),
+	}, "|"))))
+
+	return nil
+}
+
+func flattenEvents(in []api.Event) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, e := range in {
+		count := e.Count
+		if e.Series != nil {
+			count = e.Series.Count
+		}
+		if count == 0 {
+			count = 1
+		}
+		component := e.Source.Component
+		if component == "" {
+			component = e.ReportingController
+		}
+		att[i] = map[string]interface{}{
+			"type":             e.Type,
+			"reason":           e.Reason,
+			"message":          e.Message,
+			"count":            int(count),
+			"first_timestamp":  eventFirstTimestamp(e).Format(time.RFC3339),
+			"last_timestamp":   eventLastTimestamp(e).Format(time.RFC3339),
+			"source_component": component,
+		}
+	}
+	return att
+}
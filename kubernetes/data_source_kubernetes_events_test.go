@@ -0,0 +1,130 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	api "k8s.io/api/core/v1"
+	eventsv1beta1 "k8s.io/api/events/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFilterLastEvents(t *testing.T) {
+	at := func(minute int) metav1.Time {
+		return metav1.NewTime(time.Date(2018, 8, 1, 12, minute, 0, 0, time.UTC))
+	}
+	events := []api.Event{
+		{Type: api.EventTypeNormal, Reason: "Scheduled", Message: "assigned", LastTimestamp: at(1)},
+		{Type: api.EventTypeWarning, Reason: "Failed", Message: "pull failed", LastTimestamp: at(2)},
+		{Type: api.EventTypeWarning, Reason: "BackOff", Message: "back-off", LastTimestamp: at(4)},
+		{Type: api.EventTypeWarning, Reason: "Failed", Message: "pull failed", LastTimestamp: at(3)},
+		{Type: api.EventTypeNormal, Reason: "Pulling", Message: "pulling", EventTime: metav1.NewMicroTime(at(5).Time)},
+	}
+
+	reasons := func(in []api.Event) string {
+		out := make([]string, len(in))
+		for i, e := range in {
+			out[i] = e.Reason
+		}
+		return fmt.Sprintf("%v", out)
+	}
+
+	cases := []struct {
+		Type     string
+		Limit    int
+		Expected string
+	}{
+		{api.EventTypeWarning, 10, "[BackOff Failed]"},
+		{api.EventTypeWarning, 1, "[BackOff]"},
+		{api.EventTypeNormal, 10, "[Pulling Scheduled]"},
+		{"", 3, "[Pulling BackOff Failed]"},
+	}
+	for _, tc := range cases {
+		out := reasons(filterLastEvents(events, tc.Type, tc.Limit))
+		if out != tc.Expected {
+			t.Errorf("type %q, limit %d: expected %s, got %s", tc.Type, tc.Limit, tc.Expected, out)
+		}
+	}
+}
+
+func TestFlattenEventsFromEventsV1beta1(t *testing.T) {
+	first := time.Date(2018, 8, 1, 12, 0, 0, 0, time.UTC)
+	last := time.Date(2018, 8, 1, 12, 5, 0, 0, time.UTC)
+	in := eventsv1beta1.Event{
+		EventTime:           metav1.NewMicroTime(first),
+		ReportingController: "kubelet",
+		Reason:              "BackOff",
+		Note:                "Back-off restarting failed container",
+		Type:                api.EventTypeWarning,
+		Regarding:           api.ObjectReference{Kind: "Pod", Name: "web"},
+		Series: &eventsv1beta1.EventSeries{
+			Count:            7,
+			LastObservedTime: metav1.NewMicroTime(last),
+		},
+	}
+
+	out := flattenEvents([]api.Event{coreEventFromEventsV1beta1(in)})
+	expected := map[string]interface{}{
+		"type":             "Warning",
+		"reason":           "BackOff",
+		"message":          "Back-off restarting failed container",
+		"count":            7,
+		"first_timestamp":  "2018-08-01T12:00:00Z",
+		"last_timestamp":   "2018-08-01T12:05:00Z",
+		"source_component": "kubelet",
+	}
+	if !reflect.DeepEqual(out[0], expected) {
+		t.Fatalf("expected %#v, got %#v", expected, out[0])
+	}
+}
+
+func TestAccKubernetesDataSourceEvents_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceEventsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.kubernetes_events.test", "events.#"),
+					resource.TestCheckResourceAttr("data.kubernetes_events.test", "events.0.type", "Normal"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_events.test", "events.0.reason"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_events.test", "events.0.last_timestamp"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_events.test", "events.0.source_component"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceEventsConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		container {
+			image = "nginx:1.7.9"
+			name  = "tf-acc-test"
+		}
+	}
+}
+
+data "kubernetes_events" "test" {
+	involved_object {
+		kind      = "Pod"
+		name      = "${kubernetes_pod.test.metadata.0.name}"
+		namespace = "${kubernetes_pod.test.metadata.0.namespace}"
+	}
+	type  = "Normal"
+	limit = 5
+}
+`, name)
+}
@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceKubernetesIngressSchema(t *testing.T) {
+	testDataSourceSchemaIsComputed(t, dataSourceKubernetesIngress().Schema, "metadata", "metadata.0.name", "metadata.0.namespace")
+}
+
+func TestAccKubernetesDataSourceIngress_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceIngressConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_ingress.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_ingress.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_ingress.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_ingress.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.backend.0.service_name", "app1"),
+					resource.TestCheckResourceAttr("data.kubernetes_ingress.test", "spec.0.rule.0.host", "server.domain.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceIngressConfig_basic(name string) string {
+	return testAccKubernetesIngressConfig_basic(name) + `
+data "kubernetes_ingress" "test" {
+	metadata {
+		name = "${kubernetes_ingress.test.metadata.0.name}"
+		namespace = "${kubernetes_ingress.test.metadata.0.namespace}"
+	}
+}
+`
+}
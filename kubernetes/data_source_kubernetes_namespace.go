@@ -0,0 +1,24 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceKubernetesNamespace() *schema.Resource {
+	dsSchema := datasourceSchemaFromResourceSchema(resourceKubernetesNamespace().Schema)
+
+	addRequiredFieldsToSchema(dsSchema, "metadata")
+	addRequiredFieldsToSchema(dsSchema["metadata"].Elem.(*schema.Resource).Schema, "name")
+
+	return &schema.Resource{
+		Read: dataSourceKubernetesNamespaceRead,
+
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceKubernetesNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("metadata.0.name").(string))
+
+	return resourceKubernetesNamespaceRead(d, meta)
+}
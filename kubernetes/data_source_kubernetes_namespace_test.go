@@ -0,0 +1,44 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceKubernetesNamespaceSchema(t *testing.T) {
+	testDataSourceSchemaIsComputed(t, dataSourceKubernetesNamespace().Schema, "metadata", "metadata.0.name")
+}
+
+func TestAccKubernetesDataSourceNamespace_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNamespaceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_namespace.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_namespace.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_namespace.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_namespace.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_namespace.test", "metadata.0.uid"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNamespaceConfig_basic(name string) string {
+	return testAccKubernetesNamespaceConfig_basic(name) + `
+data "kubernetes_namespace" "test" {
+	metadata {
+		name = "${kubernetes_namespace.test.metadata.0.name}"
+	}
+}
+`
+}
@@ -0,0 +1,32 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNamespaces() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesNamespacesRead,
+
+		Schema: objectListSchema("namespace", false),
+	}
+}
+
+func dataSourceKubernetesNamespacesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	list := func(opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		out, err := conn.CoreV1().Namespaces().List(opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]metav1.ObjectMeta, len(out.Items), len(out.Items))
+		for i, v := range out.Items {
+			items[i] = v.ObjectMeta
+		}
+		return items, out.Continue, nil
+	}
+
+	return readObjectList(d, list, d.Get("field_selector").(string), "label_selector", "field_selector")
+}
@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceNamespaces_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNamespacesConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.test", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.test", "items.0.labels.tf-acc-test", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNamespacesConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_namespace" "test" {
+	count = 2
+	metadata {
+		name = "%s-${count.index}"
+		labels {
+			tf-acc-test = "%s"
+		}
+	}
+}
+
+data "kubernetes_namespaces" "test" {
+	label_selector = "tf-acc-test=${kubernetes_namespace.test.0.metadata.0.labels.tf-acc-test}"
+	depends_on = ["kubernetes_namespace.test"]
+}
+`, name, name)
+}
@@ -0,0 +1,201 @@
+package kubernetes
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNodes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"label_selector": {
+				Type:        schema.TypeString,
+				Description: "A selector to restrict the list of returned nodes by their labels, e.g. `node-pool=default`. Defaults to everything.",
+				Optional:    true,
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Description: "The matching nodes.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the node.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: "Map of string keys and values of the node.",
+							Computed:    true,
+						},
+						"provider_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the node assigned by the cloud provider.",
+							Computed:    true,
+						},
+						"unschedulable": {
+							Type:        schema.TypeBool,
+							Description: "Whether the node is cordoned off from scheduling new pods.",
+							Computed:    true,
+						},
+						"taints": {
+							Type:        schema.TypeList,
+							Description: "Taints applied to the node.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Description: "The taint key.",
+										Computed:    true,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Description: "The taint value.",
+										Computed:    true,
+									},
+									"effect": {
+										Type:        schema.TypeString,
+										Description: "The effect of the taint on pods that do not tolerate it, e.g. `NoSchedule`.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"addresses": {
+							Type:        schema.TypeList,
+							Description: "Addresses reachable to the node.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "Type of the address, e.g. `InternalIP` or `Hostname`.",
+										Computed:    true,
+									},
+									"address": {
+										Type:        schema.TypeString,
+										Description: "The address.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"allocatable": {
+							Type:        schema.TypeMap,
+							Description: "Resources of the node that are available for scheduling.",
+							Computed:    true,
+						},
+						"capacity": {
+							Type:        schema.TypeMap,
+							Description: "Total resources of the node.",
+							Computed:    true,
+						},
+						"conditions": {
+							Type:        schema.TypeList,
+							Description: "Current service state of the node.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "Type of the condition, e.g. `Ready`.",
+										Computed:    true,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Description: "Status of the condition, one of `True`, `False` or `Unknown`.",
+										Computed:    true,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Description: "Reason for the condition's last transition.",
+										Computed:    true,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Description: "Human readable message about the last transition.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"kubelet_version": {
+							Type:        schema.TypeString,
+							Description: "Version of the kubelet running on the node.",
+							Computed:    true,
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Description: "Zone of the node, taken from its topology labels.",
+							Computed:    true,
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Description: "Region of the node, taken from its topology labels.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"total_allocatable_cpu": {
+				Type:        schema.TypeString,
+				Description: "Sum of the allocatable CPU of the matching nodes.",
+				Computed:    true,
+			},
+			"total_allocatable_memory": {
+				Type:        schema.TypeString,
+				Description: "Sum of the allocatable memory of the matching nodes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesNodesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	labelSelector := d.Get("label_selector").(string)
+	opts := metav1.ListOptions{
+		LabelSelector: labelSelector,
+		Limit:         objectListPageSize,
+	}
+	nodes := make([]v1.Node, 0)
+	for {
+		out, err := conn.CoreV1().Nodes().List(opts)
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, out.Items...)
+		if out.Continue == "" {
+			break
+		}
+		opts.Continue = out.Continue
+	}
+
+	err := d.Set("nodes", flattenNodes(nodes))
+	if err != nil {
+		return err
+	}
+	cpu := totalAllocatable(nodes, v1.ResourceCPU)
+	err = d.Set("total_allocatable_cpu", cpu.String())
+	if err != nil {
+		return err
+	}
+	memory := totalAllocatable(nodes, v1.ResourceMemory)
+	err = d.Set("total_allocatable_memory", memory.String())
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(labelSelector)))
+
+	return nil
+}
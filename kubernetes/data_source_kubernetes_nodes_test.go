@@ -0,0 +1,34 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceNodes_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNodesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.name"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.kubelet_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.allocatable.cpu"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.capacity.memory"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "nodes.0.addresses.#"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "total_allocatable_cpu"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.test", "total_allocatable_memory"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNodesConfig_basic() string {
+	return `
+data "kubernetes_nodes" "test" {}
+`
+}
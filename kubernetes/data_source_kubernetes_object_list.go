@@ -0,0 +1,163 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// objectListPageSize is the number of objects requested per page when listing.
+const objectListPageSize = 500
+
+func objectListSchema(objectName string, namespaced bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"label_selector": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("A selector to restrict the list of returned %ss by their labels, e.g. `team=payments,env!=dev`. Defaults to everything.", objectName),
+			Optional:    true,
+		},
+		"field_selector": {
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("A selector to restrict the list of returned %ss by their fields, e.g. `metadata.name=example`. Defaults to everything.", objectName),
+			Optional:    true,
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("Names of the matching %ss.", objectName),
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"items": {
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("Metadata of the matching %ss.", objectName),
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"annotations": {
+						Type:        schema.TypeMap,
+						Description: fmt.Sprintf("An unstructured key value map stored with the %s.", objectName),
+						Computed:    true,
+					},
+					"labels": {
+						Type:        schema.TypeMap,
+						Description: fmt.Sprintf("Map of string keys and values of the %s.", objectName),
+						Computed:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: fmt.Sprintf("Name of the %s.", objectName),
+						Computed:    true,
+					},
+					"namespace": {
+						Type:        schema.TypeString,
+						Description: fmt.Sprintf("Namespace of the %s.", objectName),
+						Computed:    true,
+					},
+					"resource_version": {
+						Type:        schema.TypeString,
+						Description: fmt.Sprintf("An opaque value that represents the internal version of this %s.", objectName),
+						Computed:    true,
+					},
+					"uid": {
+						Type:        schema.TypeString,
+						Description: fmt.Sprintf("The unique in time and space value for this %s.", objectName),
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	if namespaced {
+		s["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: fmt.Sprintf("Namespace to list %ss from. Lists %ss from all namespaces when omitted.", objectName, objectName),
+			Optional:    true,
+		}
+	}
+	return s
+}
+
+// objectListFunc returns a single page of objects for the given list options
+// along with the token to retrieve the next page, if any.
+type objectListFunc func(opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error)
+
+// listObjectMeta collects the metadata of all objects matching the given
+// selectors, following continue tokens until the last page.
+func listObjectMeta(list objectListFunc, labelSelector, fieldSelector string) ([]metav1.ObjectMeta, error) {
+	opts := metav1.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
+		Limit:         objectListPageSize,
+	}
+	out := make([]metav1.ObjectMeta, 0)
+	for {
+		items, cont, err := list(opts)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, items...)
+		if cont == "" {
+			return out, nil
+		}
+		opts.Continue = cont
+	}
+}
+
+func flattenObjectList(in []metav1.ObjectMeta) ([]interface{}, []interface{}) {
+	names := make([]interface{}, len(in), len(in))
+	items := make([]interface{}, len(in), len(in))
+	for i, m := range in {
+		names[i] = m.Name
+		items[i] = map[string]interface{}{
+			"annotations":      m.Annotations,
+			"labels":           m.Labels,
+			"name":             m.Name,
+			"namespace":        m.Namespace,
+			"resource_version": m.ResourceVersion,
+			"uid":              string(m.UID),
+		}
+	}
+	return names, items
+}
+
+// readObjectList lists objects using the selectors configured on d and stores
+// the result. The ID is derived from the lookup arguments given in keys.
+func readObjectList(d *schema.ResourceData, list objectListFunc, fieldSelector string, keys ...string) error {
+	items, err := listObjectMeta(list, d.Get("label_selector").(string), fieldSelector)
+	if err != nil {
+		return err
+	}
+
+	names, flattened := flattenObjectList(items)
+	err = d.Set("names", names)
+	if err != nil {
+		return err
+	}
+	err = d.Set("items", flattened)
+	if err != nil {
+		return err
+	}
+
+	parts := make([]string, len(keys), len(keys))
+	for i, k := range keys {
+		parts[i] = d.Get(k).(string)
+	}
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(parts, "|"))))
+
+	return nil
+}
+
+// joinFieldSelectors combines field selectors, skipping empty ones.
+func joinFieldSelectors(selectors ...string) string {
+	out := make([]string, 0, len(selectors))
+	for _, s := range selectors {
+		if s != "" {
+			out = append(out, s)
+		}
+	}
+	return strings.Join(out, ",")
+}
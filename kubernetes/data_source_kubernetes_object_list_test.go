@@ -0,0 +1,64 @@
+package kubernetes
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestListObjectMetaFollowsContinueTokens(t *testing.T) {
+	pages := map[string][]metav1.ObjectMeta{
+		"":       {{Name: "one"}, {Name: "two"}},
+		"page-2": {{Name: "three"}},
+		"page-3": {{Name: "four"}},
+	}
+	next := map[string]string{
+		"":       "page-2",
+		"page-2": "page-3",
+		"page-3": "",
+	}
+
+	calls := 0
+	list := func(opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		calls++
+		if opts.LabelSelector != "team=payments" || opts.FieldSelector != "metadata.namespace!=kube-system" {
+			return nil, "", fmt.Errorf("unexpected selectors: %#v", opts)
+		}
+		if opts.Limit != objectListPageSize {
+			return nil, "", fmt.Errorf("unexpected page size: %d", opts.Limit)
+		}
+		return pages[opts.Continue], next[opts.Continue], nil
+	}
+
+	out, err := listObjectMeta(list, "team=payments", "metadata.namespace!=kube-system")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 list calls, got %d", calls)
+	}
+	names, _ := flattenObjectList(out)
+	expected := []interface{}{"one", "two", "three", "four"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected names %#v, got %#v", expected, names)
+	}
+}
+
+func TestJoinFieldSelectors(t *testing.T) {
+	cases := []struct {
+		Input    []string
+		Expected string
+	}{
+		{[]string{"", ""}, ""},
+		{[]string{"metadata.name=foo", ""}, "metadata.name=foo"},
+		{[]string{"", "type=kubernetes.io/tls"}, "type=kubernetes.io/tls"},
+		{[]string{"metadata.name=foo", "type=kubernetes.io/tls"}, "metadata.name=foo,type=kubernetes.io/tls"},
+	}
+	for _, tc := range cases {
+		if out := joinFieldSelectors(tc.Input...); out != tc.Expected {
+			t.Errorf("expected %q, got %q", tc.Expected, out)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceKubernetesPersistentVolumeClaimSchema(t *testing.T) {
+	testDataSourceSchemaIsComputed(t, dataSourceKubernetesPersistentVolumeClaim().Schema, "metadata", "metadata.0.name", "metadata.0.namespace")
+}
+
+func TestAccKubernetesDataSourcePersistentVolumeClaim_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePersistentVolumeClaimConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_persistent_volume_claim.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_persistent_volume_claim.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_persistent_volume_claim.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_persistent_volume_claim.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "spec.0.access_modes.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "5Gi"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourcePersistentVolumeClaimConfig_basic(name string) string {
+	return testAccKubernetesPersistentVolumeClaimConfig_basic(name) + `
+data "kubernetes_persistent_volume_claim" "test" {
+	metadata {
+		name = "${kubernetes_persistent_volume_claim.test.metadata.0.name}"
+		namespace = "${kubernetes_persistent_volume_claim.test.metadata.0.namespace}"
+	}
+}
+`
+}
@@ -0,0 +1,47 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceKubernetesPodSchema(t *testing.T) {
+	testDataSourceSchemaIsComputed(t, dataSourceKubernetesPod().Schema, "metadata", "metadata.0.name", "metadata.0.namespace")
+}
+
+func TestAccKubernetesDataSourcePod_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePodConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_pod.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pod.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pod.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pod.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_pod.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_pod.test", "spec.0.container.0.image", "nginx:1.7.9"),
+					resource.TestCheckResourceAttr("data.kubernetes_pod.test", "spec.0.security_context.0.run_as_user", "101"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourcePodConfig_basic(name string) string {
+	return testAccKubernetesPodConfigWithSecurityContext(name, "nginx:1.7.9") + `
+data "kubernetes_pod" "test" {
+	metadata {
+		name = "${kubernetes_pod.test.metadata.0.name}"
+		namespace = "${kubernetes_pod.test.metadata.0.namespace}"
+	}
+}
+`
+}
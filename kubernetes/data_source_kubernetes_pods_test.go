@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourcePods_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourcePodsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "names.0", name),
+					resource.TestCheckResourceAttr("data.kubernetes_pods.test", "items.0.namespace", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourcePodsConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+	metadata {
+		name = "%s"
+		labels {
+			tf-acc-test = "%s"
+		}
+	}
+	spec {
+		container {
+			image = "nginx:1.7.9"
+			name  = "tf-acc-test"
+		}
+	}
+}
+
+data "kubernetes_pods" "test" {
+	label_selector = "tf-acc-test=${kubernetes_pod.test.metadata.0.labels.tf-acc-test}"
+	field_selector = "status.phase=Running"
+}
+`, name, name)
+}
@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesSecrets() *schema.Resource {
+	s := objectListSchema("secret", true)
+	s["type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Restricts the list to secrets of the given type, e.g. `kubernetes.io/tls`.",
+		Optional:    true,
+	}
+
+	return &schema.Resource{
+		Read: dataSourceKubernetesSecretsRead,
+
+		Schema: s,
+	}
+}
+
+func dataSourceKubernetesSecretsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace := d.Get("namespace").(string)
+	list := func(opts metav1.ListOptions) ([]metav1.ObjectMeta, string, error) {
+		out, err := conn.CoreV1().Secrets(namespace).List(opts)
+		if err != nil {
+			return nil, "", err
+		}
+		items := make([]metav1.ObjectMeta, len(out.Items), len(out.Items))
+		for i, v := range out.Items {
+			items[i] = v.ObjectMeta
+		}
+		return items, out.Continue, nil
+	}
+
+	return readObjectList(d, list, joinFieldSelectors(
+		d.Get("field_selector").(string),
+		typeFieldSelector(d.Get("type").(string)),
+	), "namespace", "label_selector", "field_selector", "type")
+}
+
+func typeFieldSelector(secretType string) string {
+	if secretType == "" {
+		return ""
+	}
+	return "type=" + secretType
+}
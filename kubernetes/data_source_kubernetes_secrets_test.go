@@ -0,0 +1,64 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceSecrets_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceSecretsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_secrets.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_secrets.test", "names.0", name+"-basic-auth"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceSecretsConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_secret" "opaque" {
+	metadata {
+		name = "%s-opaque"
+		labels {
+			tf-acc-test = "%s"
+		}
+	}
+	data {
+		username = "admin"
+	}
+}
+
+resource "kubernetes_secret" "basic_auth" {
+	metadata {
+		name = "%s-basic-auth"
+		labels {
+			tf-acc-test = "%s"
+		}
+	}
+	type = "kubernetes.io/basic-auth"
+	data {
+		username = "admin"
+		password = "secret"
+	}
+}
+
+data "kubernetes_secrets" "test" {
+	namespace = "default"
+	label_selector = "tf-acc-test=%s"
+	type = "kubernetes.io/basic-auth"
+	depends_on = ["kubernetes_secret.opaque", "kubernetes_secret.basic_auth"]
+}
+`, name, name, name, name, name)
+}
@@ -0,0 +1,141 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesServerInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesServerInfoRead,
+
+		Schema: map[string]*schema.Schema{
+			"major": {
+				Type:        schema.TypeString,
+				Description: "Major version of the Kubernetes API server.",
+				Computed:    true,
+			},
+			"minor": {
+				Type:        schema.TypeString,
+				Description: "Minor version of the Kubernetes API server, e.g. `11` or `11+` on some managed clusters.",
+				Computed:    true,
+			},
+			"git_version": {
+				Type:        schema.TypeString,
+				Description: "Full version of the Kubernetes API server, e.g. `v1.11.2`.",
+				Computed:    true,
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Description: "Platform the Kubernetes API server is running on, e.g. `linux/amd64`.",
+				Computed:    true,
+			},
+			"api_versions": {
+				Type:        schema.TypeList,
+				Description: "Group versions served by the cluster, including those of custom resources, e.g. `v1` or `policy/v1beta1`.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Description: "Resources served by the cluster, including custom resources.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_version": {
+							Type:        schema.TypeString,
+							Description: "Group version serving the resource, e.g. `apps/v1`.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Plural name of the resource, e.g. `deployments`. Subresources are included as e.g. `deployments/scale`.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the resource, e.g. `Deployment`.",
+							Computed:    true,
+						},
+						"namespaced": {
+							Type:        schema.TypeBool,
+							Description: "Whether the resource is namespaced.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesServerInfoRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	// Discovery is cached on disk for several minutes, so APIs registered
+	// since (e.g. CRDs created earlier in the same apply) would be missing
+	kp.discoClient.Invalidate()
+
+	ver, err := kp.discoClient.ServerVersion()
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve server version: %s", err)
+	}
+	apiGroups, err := kp.discoClient.ServerGroups()
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve server API groups: %s", err)
+	}
+	// An unavailable aggregated API (e.g. metrics.k8s.io without a running
+	// metrics server) fails discovery for its group version only, which
+	// shouldn't stop us from listing everything else
+	resList := []*metav1.APIResourceList{}
+	for _, gv := range metav1.ExtractGroupVersions(apiGroups) {
+		resources, err := kp.discoClient.ServerResourcesForGroupVersion(gv)
+		if err != nil {
+			log.Printf("[WARN] Failed to retrieve server resources for %s: %s", gv, err)
+			continue
+		}
+		resList = append(resList, resources)
+	}
+
+	d.Set("major", ver.Major)
+	d.Set("minor", ver.Minor)
+	d.Set("git_version", ver.GitVersion)
+	d.Set("platform", ver.Platform)
+
+	groupVersions, resources := flattenAPIResourceLists(resList)
+	err = d.Set("api_versions", groupVersions)
+	if err != nil {
+		return err
+	}
+	err = d.Set("resources", resources)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(kp.cfg.Host)
+
+	return nil
+}
+
+func flattenAPIResourceLists(in []*metav1.APIResourceList) ([]interface{}, []interface{}) {
+	groupVersions := make([]interface{}, 0, len(in))
+	resources := make([]interface{}, 0)
+	for _, l := range in {
+		if l == nil {
+			continue
+		}
+		groupVersions = append(groupVersions, l.GroupVersion)
+		for _, r := range l.APIResources {
+			resources = append(resources, map[string]interface{}{
+				"group_version": l.GroupVersion,
+				"name":          r.Name,
+				"kind":          r.Kind,
+				"namespaced":    r.Namespaced,
+			})
+		}
+	}
+	return groupVersions, resources
+}
@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFlattenAPIResourceLists(t *testing.T) {
+	in := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "namespaces", Kind: "Namespace", Namespaced: false},
+				{Name: "pods", Kind: "Pod", Namespaced: true},
+			},
+		},
+		nil,
+		{
+			GroupVersion: "policy/v1beta1",
+			APIResources: []metav1.APIResource{
+				{Name: "podsecuritypolicies", Kind: "PodSecurityPolicy", Namespaced: false},
+			},
+		},
+	}
+
+	groupVersions, resources := flattenAPIResourceLists(in)
+
+	expectedGroupVersions := []interface{}{"v1", "policy/v1beta1"}
+	if !reflect.DeepEqual(groupVersions, expectedGroupVersions) {
+		t.Fatalf("expected group versions %#v, got %#v", expectedGroupVersions, groupVersions)
+	}
+	expectedResources := []interface{}{
+		map[string]interface{}{"group_version": "v1", "name": "namespaces", "kind": "Namespace", "namespaced": false},
+		map[string]interface{}{"group_version": "v1", "name": "pods", "kind": "Pod", "namespaced": true},
+		map[string]interface{}{"group_version": "policy/v1beta1", "name": "podsecuritypolicies", "kind": "PodSecurityPolicy", "namespaced": false},
+	}
+	if !reflect.DeepEqual(resources, expectedResources) {
+		t.Fatalf("expected resources %#v, got %#v", expectedResources, resources)
+	}
+}
+
+func TestAccKubernetesDataSourceServerInfo_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServerInfoConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_server_info.test", "major", "1"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_info.test", "minor"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_info.test", "git_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_info.test", "platform"),
+					resource.TestCheckResourceAttr("data.kubernetes_server_info.test", "api_versions.0", "v1"),
+					resource.TestCheckResourceAttr("data.kubernetes_server_info.test", "resources.0.group_version", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceServerInfoConfig_basic() string {
+	return `
+data "kubernetes_server_info" "test" {}
+`
+}
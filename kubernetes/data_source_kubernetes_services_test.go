@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKubernetesDataSourceServices_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServicesConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_services.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_services.test", "items.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_services.test", "items.0.namespace", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceServicesConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	count = 2
+	metadata {
+		name = "%s-${count.index}"
+		labels {
+			tf-acc-test = "%s"
+		}
+	}
+	spec {
+		port {
+			port = 8080
+		}
+	}
+}
+
+data "kubernetes_services" "test" {
+	namespace = "default"
+	label_selector = "tf-acc-test=${kubernetes_service.test.0.metadata.0.labels.tf-acc-test}"
+	depends_on = ["kubernetes_service.test"]
+}
+`, name, name)
+}
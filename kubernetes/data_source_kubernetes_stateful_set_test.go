@@ -0,0 +1,48 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDataSourceKubernetesStatefulSetSchema(t *testing.T) {
+	testDataSourceSchemaIsComputed(t, dataSourceKubernetesStatefulSet().Schema, "metadata", "metadata.0.name", "metadata.0.namespace")
+}
+
+func TestAccKubernetesDataSourceStatefulSet_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceStatefulSetConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_stateful_set.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("data.kubernetes_stateful_set.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_stateful_set.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_stateful_set.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_stateful_set.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("data.kubernetes_stateful_set.test", "spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_stateful_set.test", "spec.0.pod_management_policy", "Parallel"),
+					resource.TestCheckResourceAttr("data.kubernetes_stateful_set.test", "spec.0.selector.0.match_labels.app", "one"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceStatefulSetConfig_basic(name string) string {
+	return testAccKubernetesStatefulSetConfig_basic(name, "nginx:1.7.9", "Parallel") + `
+data "kubernetes_stateful_set" "test" {
+	metadata {
+		name = "${kubernetes_stateful_set.test.metadata.0.name}"
+		namespace = "${kubernetes_stateful_set.test.metadata.0.namespace}"
+	}
+}
+`
+}
@@ -19,6 +19,58 @@ func dataSourceKubernetesStorageClass() *schema.Resource {
 				Description: "Indicates the type of the provisioner",
 				Computed:    true,
 			},
+			"volume_binding_mode": {
+				Type:        schema.TypeString,
+				Description: "Indicates when volume binding and dynamic provisioning should occur",
+				Computed:    true,
+			},
+			"allow_volume_expansion": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether the storage class allow volume expand",
+				Computed:    true,
+			},
+			"mount_options": {
+				Type:        schema.TypeSet,
+				Description: "Persistent Volumes that are dynamically created by a storage class will have the mount options specified",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"allowed_topologies": {
+				Type:        schema.TypeList,
+				Description: "Restrict the node topologies where volumes can be dynamically provisioned.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"match_label_expressions": {
+							Type:        schema.TypeList,
+							Description: "A list of topology selector requirements by labels.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Description: "The label key that the selector applies to.",
+										Computed:    true,
+									},
+									"values": {
+										Type:        schema.TypeSet,
+										Description: "An array of string values. One value must match the label to be selected.",
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"is_default_class": {
+				Type:        schema.TypeBool,
+				Description: "Whether this storage class is the default for claims that do not request a particular class",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -4,14 +4,22 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"time"
 
 	api "k8s.io/api/core/v1"
+	eventsv1beta1 "k8s.io/api/events/v1beta1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	kubernetes "k8s.io/client-go/kubernetes"
 )
 
 func getLastWarningsForObject(conn *kubernetes.Clientset, metadata meta_v1.ObjectMeta, kind string, limit int) ([]api.Event, error) {
+	return getLastEventsForObject(conn, metadata, kind, api.EventTypeWarning, limit)
+}
+
+// getLastEventsForObject returns the latest events of the given type for the
+// object, or of any type if eventType is empty.
+func getLastEventsForObject(conn *kubernetes.Clientset, metadata meta_v1.ObjectMeta, kind, eventType string, limit int) ([]api.Event, error) {
 	m := map[string]string{
 		"involvedObject.name": metadata.Name,
 		"involvedObject.kind": kind,
@@ -29,37 +37,119 @@ func getLastWarningsForObject(conn *kubernetes.Clientset, metadata meta_v1.Objec
 		return nil, err
 	}
 
+	log.Printf("[DEBUG] Received %d events for %s/%s (%s)",
+		len(out.Items), metadata.Namespace, metadata.Name, kind)
+
+	return filterLastEvents(out.Items, eventType, limit), nil
+}
+
+// filterLastEvents returns up to limit of the latest events of the given
+// type, skipping events repeating the message of a later one.
+func filterLastEvents(events []api.Event, eventType string, limit int) []api.Event {
 	// It would be better to sort & filter on the server-side
 	// but API doesn't seem to support it
-	var warnings []api.Event
+	var filtered []api.Event
 
 	// Bring latest events to the top, for easy access
-	sort.Slice(out.Items, func(i, j int) bool {
-		return out.Items[i].LastTimestamp.After(out.Items[j].LastTimestamp.Time)
+	sort.Slice(events, func(i, j int) bool {
+		return eventLastTimestamp(events[i]).After(eventLastTimestamp(events[j]))
 	})
 
-	log.Printf("[DEBUG] Received %d events for %s/%s (%s)",
-		len(out.Items), metadata.Namespace, metadata.Name, kind)
-
-	warnCount := 0
-	uniqueWarnings := make(map[string]api.Event, 0)
-	for _, e := range out.Items {
-		if warnCount >= limit {
+	count := 0
+	unique := make(map[string]api.Event, 0)
+	for _, e := range events {
+		if count >= limit {
 			break
 		}
 
-		if e.Type == api.EventTypeWarning {
-			_, found := uniqueWarnings[e.Message]
+		if eventType == "" || e.Type == eventType {
+			_, found := unique[e.Message]
 			if found {
 				continue
 			}
-			warnings = append(warnings, e)
-			uniqueWarnings[e.Message] = e
-			warnCount++
+			filtered = append(filtered, e)
+			unique[e.Message] = e
+			count++
 		}
 	}
 
-	return warnings, nil
+	return filtered
+}
+
+// getEventsV1beta1ForObject lists the events regarding the object through the
+// events.k8s.io API, converted to core events.
+func getEventsV1beta1ForObject(conn *kubernetes.Clientset, metadata meta_v1.ObjectMeta, kind string) ([]api.Event, error) {
+	opts := meta_v1.ListOptions{
+		Limit: objectListPageSize,
+	}
+	events := make([]api.Event, 0)
+	for {
+		out, err := conn.EventsV1beta1().Events(metadata.Namespace).List(opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range out.Items {
+			if e.Regarding.Kind == kind && e.Regarding.Name == metadata.Name {
+				events = append(events, coreEventFromEventsV1beta1(e))
+			}
+		}
+		if out.Continue == "" {
+			break
+		}
+		opts.Continue = out.Continue
+	}
+
+	log.Printf("[DEBUG] Received %d events for %s/%s (%s)",
+		len(events), metadata.Namespace, metadata.Name, kind)
+
+	return events, nil
+}
+
+func coreEventFromEventsV1beta1(in eventsv1beta1.Event) api.Event {
+	out := api.Event{
+		ObjectMeta:          in.ObjectMeta,
+		InvolvedObject:      in.Regarding,
+		Related:             in.Related,
+		Reason:              in.Reason,
+		Message:             in.Note,
+		Type:                in.Type,
+		Action:              in.Action,
+		Source:              in.DeprecatedSource,
+		FirstTimestamp:      in.DeprecatedFirstTimestamp,
+		LastTimestamp:       in.DeprecatedLastTimestamp,
+		Count:               in.DeprecatedCount,
+		EventTime:           in.EventTime,
+		ReportingController: in.ReportingController,
+		ReportingInstance:   in.ReportingInstance,
+	}
+	if in.Series != nil {
+		out.Series = &api.EventSeries{
+			Count:            in.Series.Count,
+			LastObservedTime: in.Series.LastObservedTime,
+			State:            api.EventSeriesState(in.Series.State),
+		}
+	}
+	return out
+}
+
+// eventFirstTimestamp returns when the event was first observed, falling
+// back to the event time set by the newer event recorders.
+func eventFirstTimestamp(e api.Event) time.Time {
+	if !e.FirstTimestamp.IsZero() {
+		return e.FirstTimestamp.Time
+	}
+	return e.EventTime.Time
+}
+
+// eventLastTimestamp returns when the event was last observed.
+func eventLastTimestamp(e api.Event) time.Time {
+	if e.Series != nil && !e.Series.LastObservedTime.IsZero() {
+		return e.Series.LastObservedTime.Time
+	}
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	return eventFirstTimestamp(e)
 }
 
 func stringifyEvents(events []api.Event) string {
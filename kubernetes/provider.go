@@ -146,36 +146,59 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"kubernetes_deployment":    dataSourceKubernetesDeployment(),
-			"kubernetes_secret":        dataSourceKubernetesSecret(),
-			"kubernetes_service":       dataSourceKubernetesService(),
-			"kubernetes_storage_class": dataSourceKubernetesStorageClass(),
+			"kubernetes_config_map":              dataSourceKubernetesConfigMap(),
+			"kubernetes_config_maps":             dataSourceKubernetesConfigMaps(),
+			"kubernetes_cron_job":                dataSourceKubernetesCronJob(),
+			"kubernetes_daemonset":               dataSourceKubernetesDaemonSet(),
+			"kubernetes_deployment":              dataSourceKubernetesDeployment(),
+			"kubernetes_endpoints":               dataSourceKubernetesEndpoints(),
+			"kubernetes_events":                  dataSourceKubernetesEvents(),
+			"kubernetes_ingress":                 dataSourceKubernetesIngress(),
+			"kubernetes_namespace":               dataSourceKubernetesNamespace(),
+			"kubernetes_namespaces":              dataSourceKubernetesNamespaces(),
+			"kubernetes_nodes":                   dataSourceKubernetesNodes(),
+			"kubernetes_persistent_volume_claim": dataSourceKubernetesPersistentVolumeClaim(),
+			"kubernetes_pod":                     dataSourceKubernetesPod(),
+			"kubernetes_pods":                    dataSourceKubernetesPods(),
+			"kubernetes_secret":                  dataSourceKubernetesSecret(),
+			"kubernetes_secrets":                 dataSourceKubernetesSecrets(),
+			"kubernetes_server_info":             dataSourceKubernetesServerInfo(),
+			"kubernetes_service":                 dataSourceKubernetesService(),
+			"kubernetes_service_account":         dataSourceKubernetesServiceAccount(),
+			"kubernetes_services":                dataSourceKubernetesServices(),
+			"kubernetes_stateful_set":            dataSourceKubernetesStatefulSet(),
+			"kubernetes_storage_class":           dataSourceKubernetesStorageClass(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"kubernetes_cluster_role":              resourceKubernetesClusterRole(),
-			"kubernetes_cluster_role_binding":      resourceKubernetesClusterRoleBinding(),
-			"kubernetes_config_map":                resourceKubernetesConfigMap(),
-			"kubernetes_horizontal_pod_autoscaler": resourceKubernetesHorizontalPodAutoscaler(),
-			"kubernetes_job":                       resourceKubernetesJob(),
-			"kubernetes_cron_job":                  resourceKubernetesCronJob(),
-			"kubernetes_ingress":                   resourceKubernetesIngress(),
-			"kubernetes_limit_range":               resourceKubernetesLimitRange(),
-			"kubernetes_namespace":                 resourceKubernetesNamespace(),
-			"kubernetes_persistent_volume":         resourceKubernetesPersistentVolume(),
-			"kubernetes_persistent_volume_claim":   resourceKubernetesPersistentVolumeClaim(),
-			"kubernetes_pod":                       resourceKubernetesPod(),
-			"kubernetes_replication_controller":    resourceKubernetesReplicationController(),
-			"kubernetes_role":                      resourceKubernetesRole(),
-			"kubernetes_role_binding":              resourceKubernetesRoleBinding(),
-			"kubernetes_deployment":                resourceKubernetesDeployment(),
-			"kubernetes_daemonset":                 resourceKubernetesDaemonSet(),
-			"kubernetes_resource_quota":            resourceKubernetesResourceQuota(),
-			"kubernetes_secret":                    resourceKubernetesSecret(),
-			"kubernetes_service":                   resourceKubernetesService(),
-			"kubernetes_service_account":           resourceKubernetesServiceAccount(),
-			"kubernetes_stateful_set":              resourceKubernetesStatefulSet(),
-			"kubernetes_storage_class":             resourceKubernetesStorageClass(),
+			"kubernetes_certificate_signing_request": resourceKubernetesCertificateSigningRequest(),
+			"kubernetes_cluster_role":                resourceKubernetesClusterRole(),
+			"kubernetes_cluster_role_binding":        resourceKubernetesClusterRoleBinding(),
+			"kubernetes_config_map":                  resourceKubernetesConfigMap(),
+			"kubernetes_horizontal_pod_autoscaler":   resourceKubernetesHorizontalPodAutoscaler(),
+			"kubernetes_job":                         resourceKubernetesJob(),
+			"kubernetes_cron_job":                    resourceKubernetesCronJob(),
+			"kubernetes_ingress":                     resourceKubernetesIngress(),
+			"kubernetes_limit_range":                 resourceKubernetesLimitRange(),
+			"kubernetes_namespace":                   resourceKubernetesNamespace(),
+			"kubernetes_persistent_volume":           resourceKubernetesPersistentVolume(),
+			"kubernetes_persistent_volume_claim":     resourceKubernetesPersistentVolumeClaim(),
+			"kubernetes_pod":                         resourceKubernetesPod(),
+			"kubernetes_pod_preset":                  resourceKubernetesPodPreset(),
+			"kubernetes_pod_security_policy":         resourceKubernetesPodSecurityPolicy(),
+			"kubernetes_replica_set":                 resourceKubernetesReplicaSet(),
+			"kubernetes_replication_controller":      resourceKubernetesReplicationController(),
+			"kubernetes_role":                        resourceKubernetesRole(),
+			"kubernetes_role_binding":                resourceKubernetesRoleBinding(),
+			"kubernetes_deployment":                  resourceKubernetesDeployment(),
+			"kubernetes_endpoints":                   resourceKubernetesEndpoints(),
+			"kubernetes_daemonset":                   resourceKubernetesDaemonSet(),
+			"kubernetes_resource_quota":              resourceKubernetesResourceQuota(),
+			"kubernetes_secret":                      resourceKubernetesSecret(),
+			"kubernetes_service":                     resourceKubernetesService(),
+			"kubernetes_service_account":             resourceKubernetesServiceAccount(),
+			"kubernetes_stateful_set":                resourceKubernetesStatefulSet(),
+			"kubernetes_storage_class":               resourceKubernetesStorageClass(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
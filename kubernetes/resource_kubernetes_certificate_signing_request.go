@@ -0,0 +1,276 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/certificates/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	kubernetes "k8s.io/client-go/kubernetes"
+)
+
+func resourceKubernetesCertificateSigningRequest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesCertificateSigningRequestCreate,
+		Read:   resourceKubernetesCertificateSigningRequestRead,
+		Exists: resourceKubernetesCertificateSigningRequestExists,
+		Update: resourceKubernetesCertificateSigningRequestUpdate,
+		Delete: resourceKubernetesCertificateSigningRequestDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("certificate signing request", true),
+			"auto_approve": {
+				Type:        schema.TypeBool,
+				Description: "Automatically approve the certificate signing request through the approval subresource. Setting it on an existing request approves it in place; an approval cannot be withdrawn.",
+				Optional:    true,
+				Default:     false,
+			},
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "The certificate request itself. The whole spec is ForceNew, as a certificate signing request cannot be changed once submitted.",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"request": {
+							Type:        schema.TypeString,
+							Description: "PEM encoded PKCS#10 certificate signing request.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"usages": {
+							Type:        schema.TypeSet,
+							Description: "Set of usage contexts the issued certificate will be valid for. Defaults to `digital signature` and `key encipherment` when omitted. See: https://tools.ietf.org/html/rfc5280#section-4.2.1.3",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAttributeValueIsIn(certificateKeyUsages),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Description: "PEM encoded certificate issued by the cluster once the request has been approved.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+var certificateKeyUsages = []string{
+	string(api.UsageSigning),
+	string(api.UsageDigitalSignature),
+	string(api.UsageContentCommittment),
+	string(api.UsageKeyEncipherment),
+	string(api.UsageKeyAgreement),
+	string(api.UsageDataEncipherment),
+	string(api.UsageCertSign),
+	string(api.UsageCRLSign),
+	string(api.UsageEncipherOnly),
+	string(api.UsageDecipherOnly),
+	string(api.UsageAny),
+	string(api.UsageServerAuth),
+	string(api.UsageClientAuth),
+	string(api.UsageCodeSigning),
+	string(api.UsageEmailProtection),
+	string(api.UsageSMIME),
+	string(api.UsageIPsecEndSystem),
+	string(api.UsageIPsecTunnel),
+	string(api.UsageIPsecUser),
+	string(api.UsageTimestamping),
+	string(api.UsageOCSPSigning),
+	string(api.UsageMicrosoftSGC),
+	string(api.UsageNetscapSGC),
+}
+
+func resourceKubernetesCertificateSigningRequestCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	csr := api.CertificateSigningRequest{
+		ObjectMeta: metadata,
+		Spec:       expandCertificateSigningRequestSpec(d.Get("spec").([]interface{})),
+	}
+
+	log.Printf("[INFO] Creating new certificate signing request: %#v", csr)
+	out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Create(&csr)
+	if err != nil {
+		return fmt.Errorf("Failed to create certificate signing request: %s", err)
+	}
+	log.Printf("[INFO] Submitted new certificate signing request: %#v", out)
+	d.SetId(out.Name)
+
+	if d.Get("auto_approve").(bool) {
+		err = approveCertificateSigningRequest(conn, out)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Waiting for certificate signing request %s to be issued", out.Name)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate),
+		waitForCertificateSigningRequestIssuedFunc(conn, out.Name))
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Certificate signing request %s issued", out.Name)
+
+	return resourceKubernetesCertificateSigningRequestRead(d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Reading certificate signing request %s", name)
+	csr, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received certificate signing request: %#v", csr)
+	err = d.Set("metadata", flattenMetadata(csr.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", flattenCertificateSigningRequestSpec(csr.Spec))
+	if err != nil {
+		return err
+	}
+
+	d.Set("certificate", string(csr.Status.Certificate))
+
+	return nil
+}
+
+func resourceKubernetesCertificateSigningRequestUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating certificate signing request %q: %v", name, string(data))
+	out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Patch(name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update certificate signing request: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated certificate signing request: %#v", out)
+	d.SetId(out.Name)
+
+	// Only a switch to true can be acted upon, an approval cannot be withdrawn
+	if d.HasChange("auto_approve") && d.Get("auto_approve").(bool) && !isCertificateSigningRequestApproved(out) {
+		err = approveCertificateSigningRequest(conn, out)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Waiting for certificate signing request %s to be issued", name)
+		err = resource.Retry(d.Timeout(schema.TimeoutUpdate),
+			waitForCertificateSigningRequestIssuedFunc(conn, name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceKubernetesCertificateSigningRequestRead(d, meta)
+}
+
+func resourceKubernetesCertificateSigningRequestDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting certificate signing request: %#v", name)
+	err := conn.CertificatesV1beta1().CertificateSigningRequests().Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Certificate signing request %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesCertificateSigningRequestExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	name := d.Id()
+	log.Printf("[INFO] Checking certificate signing request %s", name)
+	_, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+func isCertificateSigningRequestApproved(csr *api.CertificateSigningRequest) bool {
+	for _, c := range csr.Status.Conditions {
+		if c.Type == api.CertificateApproved {
+			return true
+		}
+	}
+	return false
+}
+
+func approveCertificateSigningRequest(conn *kubernetes.Clientset, csr *api.CertificateSigningRequest) error {
+	csr.Status.Conditions = append(csr.Status.Conditions, api.CertificateSigningRequestCondition{
+		Type:           api.CertificateApproved,
+		Reason:         "TerraformAutoApprove",
+		Message:        "This CSR was approved by the Terraform Kubernetes provider.",
+		LastUpdateTime: metav1.Now(),
+	})
+	log.Printf("[INFO] Approving certificate signing request %s", csr.Name)
+	_, err := conn.CertificatesV1beta1().CertificateSigningRequests().UpdateApproval(csr)
+	if err != nil {
+		return fmt.Errorf("Failed to approve certificate signing request: %s", err)
+	}
+	return nil
+}
+
+func waitForCertificateSigningRequestIssuedFunc(conn *kubernetes.Clientset, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		csr, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, c := range csr.Status.Conditions {
+			if c.Type == api.CertificateDenied {
+				return resource.NonRetryableError(fmt.Errorf("Certificate signing request %s was denied: %s (%s)",
+					csr.Name, c.Message, c.Reason))
+			}
+		}
+
+		if len(csr.Status.Certificate) > 0 {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Waiting for certificate signing request %s to be issued", csr.Name))
+	}
+}
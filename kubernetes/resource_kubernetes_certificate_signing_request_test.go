@@ -0,0 +1,165 @@
+package kubernetes
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/certificates/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesCertificateSigningRequest_basic(t *testing.T) {
+	var conf api.CertificateSigningRequest
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	request := testAccKubernetesCertificateSigningRequestPEM(t, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_certificate_signing_request.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesCertificateSigningRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCertificateSigningRequestConfig_basic(name, request),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCertificateSigningRequestExists("kubernetes_certificate_signing_request.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_certificate_signing_request.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_certificate_signing_request.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_certificate_signing_request.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_certificate_signing_request.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_certificate_signing_request.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_certificate_signing_request.test", "auto_approve", "true"),
+					resource.TestCheckResourceAttr("kubernetes_certificate_signing_request.test", "spec.0.usages.#", "3"),
+					resource.TestCheckResourceAttrSet("kubernetes_certificate_signing_request.test", "certificate"),
+					testAccCheckCertificateSigningRequestApproved(&conf),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCertificateSigningRequest_defaultUsages(t *testing.T) {
+	var conf api.CertificateSigningRequest
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	request := testAccKubernetesCertificateSigningRequestPEM(t, name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_certificate_signing_request.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesCertificateSigningRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCertificateSigningRequestConfig_defaultUsages(name, request),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCertificateSigningRequestExists("kubernetes_certificate_signing_request.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_certificate_signing_request.test", "spec.0.usages.#", "2"),
+					resource.TestCheckResourceAttrSet("kubernetes_certificate_signing_request.test", "certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCertificateSigningRequestApproved(csr *api.CertificateSigningRequest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if isCertificateSigningRequestApproved(csr) {
+			return nil
+		}
+		return fmt.Errorf("Certificate signing request %s has not been approved", csr.Name)
+	}
+}
+
+func testAccCheckKubernetesCertificateSigningRequestDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_certificate_signing_request" {
+			continue
+		}
+		name := rs.Primary.ID
+		resp, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Name == rs.Primary.ID {
+				return fmt.Errorf("Certificate signing request still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesCertificateSigningRequestExists(n string, obj *api.CertificateSigningRequest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+		name := rs.Primary.ID
+		out, err := conn.CertificatesV1beta1().CertificateSigningRequests().Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesCertificateSigningRequestPEM(t *testing.T, commonName string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   commonName,
+			Organization: []string{"system:nodes"},
+		},
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func testAccKubernetesCertificateSigningRequestConfig_basic(name, request string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_certificate_signing_request" "test" {
+	metadata {
+		name = "%s"
+	}
+	auto_approve = true
+	spec {
+		request = <<EOT
+%sEOT
+		usages = ["client auth", "digital signature", "key encipherment"]
+	}
+}`, name, request)
+}
+
+func testAccKubernetesCertificateSigningRequestConfig_defaultUsages(name, request string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_certificate_signing_request" "test" {
+	metadata {
+		name = "%s"
+	}
+	auto_approve = true
+	spec {
+		request = <<EOT
+%sEOT
+	}
+}`, name, request)
+}
@@ -21,17 +21,30 @@ func resourceKubernetesClusterRole() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceKubernetesClusterRoleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("cluster role", true),
 			"rule": {
-				Type:        schema.TypeList,
-				Description: "List of PolicyRules for this ClusterRole",
-				Required:    true,
-				MinItems:    1,
+				Type:          schema.TypeList,
+				Description:   "List of PolicyRules for this ClusterRole",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"aggregation_rule"},
 				Elem: &schema.Resource{
 					Schema: policyRuleFields(),
 				},
 			},
+			"aggregation_rule": {
+				Type:          schema.TypeList,
+				Description:   "Describes how to build the Rules for this ClusterRole. If AggregationRule is set, then the Rules are controller managed and direct changes to Rules will be stomped by the controller.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"rule"},
+				Elem: &schema.Resource{
+					Schema: aggregationRuleFields(),
+				},
+			},
 		},
 	}
 }
@@ -44,6 +57,9 @@ func resourceKubernetesClusterRoleCreate(d *schema.ResourceData, meta interface{
 		ObjectMeta: metadata,
 		Rules:      expandClusterRoleRule(d.Get("rule").([]interface{})),
 	}
+	if v, ok := d.GetOk("aggregation_rule"); ok {
+		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
+	}
 	log.Printf("[INFO] Creating new cluster role: %#v", cRole)
 	out, err := conn.RbacV1().ClusterRoles().Create(&cRole)
 	if err != nil {
@@ -74,6 +90,7 @@ func resourceKubernetesClusterRoleRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	d.Set("rule", flattenClusterRoleRules(cRole.Rules))
+	d.Set("aggregation_rule", flattenClusterRoleAggregationRule(cRole.AggregationRule))
 
 	return nil
 }
@@ -91,6 +108,9 @@ func resourceKubernetesClusterRoleUpdate(d *schema.ResourceData, meta interface{
 		ObjectMeta: metadata,
 		Rules:      expandClusterRoleRule(d.Get("rule").([]interface{})),
 	}
+	if v, ok := d.GetOk("aggregation_rule"); ok {
+		cRole.AggregationRule = expandClusterRoleAggregationRule(v.([]interface{}))
+	}
 
 	log.Printf("[INFO] Updating cluster role %q: %v", name, cRole)
 	out, err := conn.RbacV1().ClusterRoles().Update(&cRole)
@@ -140,3 +160,21 @@ func resourceKubernetesClusterRoleExists(d *schema.ResourceData, meta interface{
 	}
 	return true, err
 }
+
+// resourceKubernetesClusterRoleCustomizeDiff keeps the controller managed rules
+// of an aggregated cluster role out of the plan. Rules of an aggregated cluster
+// role are filled in by the controller from the matching cluster roles, so they
+// can't be known at plan time. Once the aggregation rule is removed, the rules
+// previously aggregated into state must not be written back as static rules.
+func resourceKubernetesClusterRoleCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("aggregation_rule") {
+		return nil
+	}
+	if len(diff.Get("aggregation_rule").([]interface{})) > 0 {
+		return diff.SetNewComputed("rule")
+	}
+	if diff.Id() != "" && !diff.HasChange("rule") {
+		return diff.SetNew("rule", []interface{}{})
+	}
+	return nil
+}
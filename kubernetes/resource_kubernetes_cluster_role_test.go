@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -11,6 +12,119 @@ import (
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func TestResourceKubernetesClusterRoleCustomizeDiff(t *testing.T) {
+	// An aggregated cluster role, with the rules filled in by the controller
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":                  "test",
+			"metadata.#":          "1",
+			"metadata.0.name":     "test",
+			"rule.#":              "1",
+			"rule.0.api_groups.#": "1",
+			"rule.0.api_groups.0": "",
+			"rule.0.resources.#":  "1",
+			"rule.0.resources.0":  "pods",
+			"rule.0.verbs.#":      "1",
+			"rule.0.verbs.0":      "get",
+			"aggregation_rule.#":  "1",
+			"aggregation_rule.0.cluster_role_selectors.#":                   "1",
+			"aggregation_rule.0.cluster_role_selectors.0.match_labels.%":    "1",
+			"aggregation_rule.0.cluster_role_selectors.0.match_labels.team": "platform",
+		},
+	}
+	metadata := []interface{}{map[string]interface{}{"name": "test"}}
+	rule := []interface{}{
+		map[string]interface{}{
+			"api_groups": []interface{}{""},
+			"resources":  []interface{}{"secrets"},
+			"verbs":      []interface{}{"get"},
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		Raw           map[string]interface{}
+		ExpectedRules string
+		Computed      bool
+	}{
+		{
+			Name: "aggregation rule kept",
+			Raw: map[string]interface{}{
+				"metadata": metadata,
+				"aggregation_rule": []interface{}{
+					map[string]interface{}{
+						"cluster_role_selectors": []interface{}{
+							map[string]interface{}{"match_labels": map[string]interface{}{"team": "platform"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "aggregation rule changed",
+			Raw: map[string]interface{}{
+				"metadata": metadata,
+				"aggregation_rule": []interface{}{
+					map[string]interface{}{
+						"cluster_role_selectors": []interface{}{
+							map[string]interface{}{"match_labels": map[string]interface{}{"team": "storage"}},
+						},
+					},
+				},
+			},
+			Computed: true,
+		},
+		{
+			Name:          "aggregation rule removed",
+			Raw:           map[string]interface{}{"metadata": metadata},
+			ExpectedRules: "0",
+		},
+		{
+			Name:          "aggregation rule replaced by rules",
+			Raw:           map[string]interface{}{"metadata": metadata, "rule": rule},
+			ExpectedRules: "secrets",
+		},
+	}
+
+	r := resourceKubernetesClusterRole()
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := config.NewRawConfig(tc.Raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			diff, err := r.Diff(state, terraform.NewResourceConfig(c), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			var attr *terraform.ResourceAttrDiff
+			if diff != nil {
+				attr = diff.Attributes["rule.#"]
+			}
+			switch {
+			case tc.Computed:
+				if attr == nil || !attr.NewComputed {
+					t.Fatalf("expected rules to be computed, got %#v", attr)
+				}
+			case tc.ExpectedRules == "":
+				if attr != nil && attr.Old != attr.New {
+					t.Fatalf("expected no change to rules, got %#v", attr)
+				}
+			case tc.ExpectedRules == "0":
+				if attr == nil || attr.New != "0" {
+					t.Fatalf("expected rules to be removed, got %#v", attr)
+				}
+			default:
+				res := diff.Attributes["rule.0.resources.0"]
+				if res == nil || res.New != "secrets" {
+					t.Fatalf("expected configured rules to be kept, got %#v", res)
+				}
+			}
+		})
+	}
+}
+
 func TestAccKubernetesClusterRole_basic(t *testing.T) {
 	var conf api.ClusterRole
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -53,6 +167,30 @@ func TestAccKubernetesClusterRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterRole_aggregationRule(t *testing.T) {
+	var conf api.ClusterRole
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_cluster_role.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesClusterRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterRoleConfig_aggregationRule(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesClusterRoleExists("kubernetes_cluster_role.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "aggregation_rule.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "aggregation_rule.0.cluster_role_selectors.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "aggregation_rule.0.cluster_role_selectors.0.match_labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_cluster_role.test", "aggregation_rule.0.cluster_role_selectors.0.match_labels.aggregate-to-"+name, "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesClusterRole_importBasic(t *testing.T) {
 	resourceName := "kubernetes_cluster_role.test"
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -152,3 +290,34 @@ resource "kubernetes_cluster_role" "test" {
 	}
 }`, name)
 }
+
+func testAccKubernetesClusterRoleConfig_aggregationRule(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_cluster_role" "component" {
+	metadata {
+		name = "%s-component"
+		labels {
+			aggregate-to-%s = "true"
+		}
+	}
+	rule {
+		api_groups = [""]
+		resources  = ["pods"]
+		verbs      = ["get", "list"]
+	}
+}
+
+resource "kubernetes_cluster_role" "test" {
+	metadata {
+		name = "%s"
+	}
+	aggregation_rule {
+		cluster_role_selectors {
+			match_labels {
+				aggregate-to-%s = "true"
+			}
+		}
+	}
+	depends_on = ["kubernetes_cluster_role.component"]
+}`, name, name, name, name)
+}
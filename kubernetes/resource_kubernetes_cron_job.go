@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	"k8s.io/api/batch/v1beta1"
 	"k8s.io/api/batch/v2alpha1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -29,6 +30,9 @@ func resourceKubernetesCronJob() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		SchemaVersion: 1,
+		MigrateState:  resourceKubernetesCronJobStateUpgrader,
+		CustomizeDiff: podSpecBlockVolumesCustomizeDiff("spec.0.job_template.0.spec.0.template.0.spec", ""),
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("cronjob", true),
 			"spec": {
@@ -304,3 +308,24 @@ func readCronJob(kp *kubernetesProvider, namespace, name string) (cj *v1beta1.Cr
 
 	return cj, err
 }
+
+func resourceKubernetesCronJobStateUpgrader(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	var err error
+
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Kubernetes CronJob State v0; migrating to v1")
+		is, err = migrateContainerResourcesState(is)
+
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+
+	return is, err
+}
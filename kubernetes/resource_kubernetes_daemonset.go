@@ -32,8 +32,12 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		SchemaVersion: 1,
+		SchemaVersion: 3,
 		MigrateState:  resourceKubernetesDaemonSetStateUpgrader,
+		CustomizeDiff: sequenceCustomizeDiff(
+			templateSelectorCustomizeDiff("spec.0.selector", "spec.0.template.0.metadata.0.labels"),
+			podSpecBlockVolumesCustomizeDiff("spec.0.template.0.spec", ""),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -57,9 +61,13 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 							Default:     0,
 						},
 						"selector": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Description: "A label query over pods that should match the Replicas count. If Selector is empty, it is defaulted to the labels present on the Pod template. Label keys and values that must match in order to be controlled by this deployment, if empty defaulted to labels on Pod template. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors",
 							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: labelSelectorFields(false),
+							},
 						},
 						"strategy": {
 							Type:        schema.TypeList,
@@ -141,6 +149,7 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 					},
 				},
 			},
+			"status": daemonSetStatusSchema(),
 		},
 	}
 }
@@ -240,6 +249,11 @@ func resourceKubernetesDaemonSetRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	err = d.Set("status", flattenDaemonSetStatus(daemonset.Status))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -414,6 +428,17 @@ func resourceKubernetesDaemonSetStateUpgrader(
 		if err != nil {
 			return is, err
 		}
+		fallthrough
+	case 1:
+		log.Println("[INFO] Found Kubernetes DaemonSet State v1; migrating to v2")
+		is, err = migrateContainerResourcesState(is)
+		if err != nil {
+			return is, err
+		}
+		fallthrough
+	case 2:
+		log.Println("[INFO] Found Kubernetes DaemonSet State v2; migrating to v3")
+		is, err = migrateSelectorMapState(is, "spec.0.selector")
 
 	default:
 		return is, fmt.Errorf("Unexpected schema version: %d", v)
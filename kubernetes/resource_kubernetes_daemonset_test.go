@@ -55,6 +55,7 @@ func TestAccKubernetesDaemonSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_daemonset.test", "metadata.0.labels.TestLabelOne", "one"),
 					resource.TestCheckResourceAttr("kubernetes_daemonset.test", "metadata.0.labels.TestLabelTwo", "two"),
 					resource.TestCheckResourceAttr("kubernetes_daemonset.test", "metadata.0.labels.TestLabelThree", "three"),
+					resource.TestCheckResourceAttr("kubernetes_daemonset.test", "status.#", "1"),
 					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{"TestLabelOne": "one", "TestLabelTwo": "two", "TestLabelThree": "three"}),
 					resource.TestCheckResourceAttr("kubernetes_daemonset.test", "metadata.0.name", name),
 					resource.TestCheckResourceAttrSet("kubernetes_daemonset.test", "metadata.0.generation"),
@@ -249,7 +250,9 @@ resource "kubernetes_daemonset" "test" {
   }
   spec {
     selector {
-      foo = "bar"
+      match_labels {
+        foo = "bar"
+      }
     }
     template {
 			metadata {
@@ -286,9 +289,11 @@ resource "kubernetes_daemonset" "test" {
   }
   spec {
     selector {
-      TestLabelOne = "one"
-      TestLabelTwo = "two"
-      TestLabelThree = "three"
+      match_labels {
+        TestLabelOne = "one"
+        TestLabelTwo = "two"
+        TestLabelThree = "three"
+      }
     }
     template {
 			metadata {
@@ -326,9 +331,11 @@ resource "kubernetes_daemonset" "test" {
   }
   spec {
     selector {
-      TestLabelOne = "one"
-      TestLabelTwo = "two"
-      TestLabelThree = "three"
+      match_labels {
+        TestLabelOne = "one"
+        TestLabelTwo = "two"
+        TestLabelThree = "three"
+      }
     }
     template {
 			metadata {
@@ -361,7 +368,9 @@ resource "kubernetes_daemonset" "test" {
 
   spec {
     selector {
-      Test = "TfAcceptanceTest"
+      match_labels {
+        Test = "TfAcceptanceTest"
+      }
 		}
     template {
 			metadata {
@@ -399,7 +408,9 @@ resource "kubernetes_daemonset" "test" {
 
   spec {
     selector {
-      Test = "TfAcceptanceTest"
+      match_labels {
+        Test = "TfAcceptanceTest"
+      }
 		}
     template {
 			metadata {
@@ -437,7 +448,9 @@ resource "kubernetes_daemonset" "test" {
 
   spec {
     selector {
-			foo = "bar"
+      match_labels {
+  			foo = "bar"
+      }
 		}
     template {
 			metadata {
@@ -471,7 +484,9 @@ resource "kubernetes_daemonset" "test" {
 
   spec {
     selector {
-			foo = "bar"
+      match_labels {
+  			foo = "bar"
+      }
 		}
     template {
 			metadata {
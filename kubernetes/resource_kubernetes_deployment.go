@@ -35,8 +35,12 @@ func resourceKubernetesDeployment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		SchemaVersion: 2,
+		SchemaVersion: 4,
 		MigrateState:  resourceKubernetesDeploymentStateUpgrader,
+		CustomizeDiff: sequenceCustomizeDiff(
+			templateSelectorCustomizeDiff("spec.0.selector", "spec.0.template.0.metadata.0.labels"),
+			podSpecBlockVolumesCustomizeDiff("spec.0.template.0.spec", ""),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -89,10 +93,14 @@ func resourceKubernetesDeployment() *schema.Resource {
 							Default:     10,
 						},
 						"selector": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Description: "A label query over pods that should match the Replicas count. If Selector is empty, it is defaulted to the labels present on the Pod template. Label keys and values that must match in order to be controlled by this deployment, if empty defaulted to labels on Pod template. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors",
 							Optional:    true,
 							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: labelSelectorFields(false),
+							},
 						},
 						"strategy": {
 							Type:        schema.TypeList,
@@ -174,6 +182,7 @@ func resourceKubernetesDeployment() *schema.Resource {
 					},
 				},
 			},
+			"status": deploymentStatusSchema(),
 		},
 	}
 }
@@ -344,6 +353,11 @@ func resourceKubernetesDeploymentRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	err = d.Set("status", flattenDeploymentStatus(*deployment))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -606,9 +620,27 @@ func resourceKubernetesDeploymentStateUpgrader(
 	case 0:
 		log.Println("[INFO] Found Kubernetes Deployment State v0; migrating to v1")
 		is, err = migrateStateV0toV1(is)
+		if err != nil {
+			return is, err
+		}
+		fallthrough
 	case 1:
 		log.Println("[INFO] Found Kubernetes Deployment State v1; migrating to v2")
 		is, err = migrateStateV1toV2(is)
+		if err != nil {
+			return is, err
+		}
+		fallthrough
+	case 2:
+		log.Println("[INFO] Found Kubernetes Deployment State v2; migrating to v3")
+		is, err = migrateContainerResourcesState(is)
+		if err != nil {
+			return is, err
+		}
+		fallthrough
+	case 3:
+		log.Println("[INFO] Found Kubernetes Deployment State v3; migrating to v4")
+		is, err = migrateSelectorMapState(is, "spec.0.selector")
 
 	default:
 		return is, fmt.Errorf("Unexpected schema version: %d", v)
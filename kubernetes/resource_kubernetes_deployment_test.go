@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -36,6 +37,41 @@ func TestAccKubernetesDeployment_minimal(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeployment_selectorMatchExpressions(t *testing.T) {
+	t.Parallel()
+
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_deployment.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDeploymentConfig_selectorMatchExpressions(name, "staging"),
+				ExpectError: regexp.MustCompile("does not match template labels"),
+			},
+			{
+				Config: testAccKubernetesDeploymentConfig_selectorMatchExpressions(name, "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_labels.app", "tf-acc-test"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_expressions.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_expressions.0.key", "environment"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_expressions.0.operator", "In"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_expressions.0.values.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_expressions.1.key", "tier"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.selector.0.match_expressions.1.operator", "Exists"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDeployment_basic(t *testing.T) {
 	t.Parallel()
 
@@ -59,6 +95,7 @@ func TestAccKubernetesDeployment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_deployment.test", "metadata.0.labels.%", "3"),
 					resource.TestCheckResourceAttr("kubernetes_deployment.test", "metadata.0.labels.TestLabelOne", "one"),
 					resource.TestCheckResourceAttr("kubernetes_deployment.test", "metadata.0.labels.TestLabelTwo", "two"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "status.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_deployment.test", "metadata.0.labels.TestLabelThree", "three"),
 					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{"TestLabelOne": "one", "TestLabelTwo": "two", "TestLabelThree": "three"}),
 					resource.TestCheckResourceAttr("kubernetes_deployment.test", "metadata.0.name", name),
@@ -393,7 +430,9 @@ resource "kubernetes_deployment" "test" {
   spec {
 		replicas = 3
     selector {
-      foo = "bar"
+      match_labels {
+        foo = "bar"
+      }
     }
     template {
 			metadata {
@@ -413,6 +452,47 @@ resource "kubernetes_deployment" "test" {
 `, name)
 }
 
+func testAccKubernetesDeploymentConfig_selectorMatchExpressions(name, environment string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    selector {
+      match_labels {
+        app = "tf-acc-test"
+      }
+      match_expressions {
+        key      = "environment"
+        operator = "In"
+        values   = ["production", "qa"]
+      }
+      match_expressions {
+        key      = "tier"
+        operator = "Exists"
+      }
+    }
+    template {
+      metadata {
+        labels {
+          app         = "tf-acc-test"
+          environment = "%s"
+          tier        = "frontend"
+        }
+      }
+      spec {
+        container {
+          image = "nginx:1.7.8"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}
+`, name, environment)
+}
+
 func testAccKubernetesDeploymentConfig_basic(name string, replicas int) string {
 	return fmt.Sprintf(`
 resource "kubernetes_deployment" "test" {
@@ -435,9 +515,11 @@ resource "kubernetes_deployment" "test" {
     replicas = %d
 
     selector {
-      TestLabelOne   = "one"
-      TestLabelTwo   = "two"
-      TestLabelThree = "three"
+      match_labels {
+        TestLabelOne   = "one"
+        TestLabelTwo   = "two"
+        TestLabelThree = "three"
+      }
     }
 
     template {
@@ -484,9 +566,11 @@ resource "kubernetes_deployment" "test" {
     revision_history_limit    = 4
 
     selector {
-      TestLabelOne   = "one"
-      TestLabelTwo   = "two"
-      TestLabelThree = "three"
+      match_labels {
+        TestLabelOne   = "one"
+        TestLabelTwo   = "two"
+        TestLabelThree = "three"
+      }
     }
 
     template {
@@ -521,8 +605,10 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-      foo = "bar"
-      Test = "TfAcceptanceTest"
+      match_labels {
+        foo = "bar"
+        Test = "TfAcceptanceTest"
+      }
     }
     template {
 		metadata {
@@ -561,8 +647,10 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-			foo = "bar"
-      Test = "TfAcceptanceTest"
+      match_labels {
+  			foo = "bar"
+        Test = "TfAcceptanceTest"
+      }
 		}
     template {
 			metadata {
@@ -600,7 +688,9 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-			foo = "bar"
+      match_labels {
+  			foo = "bar"
+      }
 		}
     template {
 			metadata {
@@ -634,7 +724,9 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-			foo = "bar"
+      match_labels {
+  			foo = "bar"
+      }
 		}
     template {
 			metadata {
@@ -663,7 +755,9 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-      foo = "bar"
+      match_labels {
+        foo = "bar"
+      }
     }
 
     strategy {
@@ -698,7 +792,9 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-      foo = "bar"
+      match_labels {
+        foo = "bar"
+      }
     }
 
     strategy {
@@ -737,7 +833,9 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-      foo = "bar"
+      match_labels {
+        foo = "bar"
+      }
     }
 
     strategy {
@@ -773,7 +871,9 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-      foo = "bar"
+      match_labels {
+        foo = "bar"
+      }
     }
 
     template {
@@ -814,7 +914,9 @@ resource "kubernetes_deployment" "test" {
 
   spec {
     selector {
-      foo = "bar"
+      match_labels {
+        foo = "bar"
+      }
     }
 
     template {
@@ -0,0 +1,269 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesEndpointsCreate,
+		Read:   resourceKubernetesEndpointsRead,
+		Exists: resourceKubernetesEndpointsExists,
+		Update: resourceKubernetesEndpointsUpdate,
+		Delete: resourceKubernetesEndpointsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceKubernetesEndpointsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("endpoints", false),
+			"subset": {
+				Type:        schema.TypeList,
+				Description: "Set of addresses and ports that comprise a service. More info: https://kubernetes.io/docs/concepts/services-networking/service/#services-without-selectors",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: endpointSubsetFields(),
+				},
+			},
+		},
+	}
+}
+
+func endpointSubsetFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"address": {
+			Type:        schema.TypeList,
+			Description: "IP addresses which offer the related ports that are marked as ready. These endpoints should be considered safe for load balancers and clients to utilize.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: endpointAddressFields(),
+			},
+		},
+		"not_ready_address": {
+			Type:        schema.TypeList,
+			Description: "IP addresses which offer the related ports but are not currently marked as ready because they have not yet finished starting, have recently failed a readiness check, or have recently failed a liveness check.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: endpointAddressFields(),
+			},
+		},
+		"port": {
+			Type:        schema.TypeList,
+			Description: "Port numbers available on the related IP addresses.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Description:  "The name of this port within the endpoint. Must match the name of the corresponding service port. Optional if only one port is defined.",
+						Optional:     true,
+						ValidateFunc: validatePortName,
+					},
+					"port": {
+						Type:         schema.TypeInt,
+						Description:  "The port that will be exposed by this endpoint.",
+						Required:     true,
+						ValidateFunc: validatePortNum,
+					},
+					"protocol": {
+						Type:         schema.TypeString,
+						Description:  "The IP protocol for this port. Supports `TCP` and `UDP`. Default is `TCP`.",
+						Optional:     true,
+						Default:      "TCP",
+						ValidateFunc: validateAttributeValueIsIn([]string{"TCP", "UDP"}),
+					},
+				},
+			},
+		},
+	}
+}
+
+func endpointAddressFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"hostname": {
+			Type:        schema.TypeString,
+			Description: "The Hostname of this endpoint.",
+			Optional:    true,
+		},
+		"ip": {
+			Type:        schema.TypeString,
+			Description: "The IP of this endpoint. May not be loopback (127.0.0.0/8), link-local (169.254.0.0/16), or link-local multicast ((224.0.0.0/24).",
+			Required:    true,
+		},
+		"node_name": {
+			Type:        schema.TypeString,
+			Description: "Node hosting this endpoint. This can be used to determine endpoints local to a node.",
+			Optional:    true,
+		},
+	}
+}
+
+func resourceKubernetesEndpointsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	ep := api.Endpoints{
+		ObjectMeta: metadata,
+		Subsets:    expandEndpointSubsets(d.Get("subset").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new endpoints: %#v", ep)
+	out, err := conn.CoreV1().Endpoints(metadata.Namespace).Create(&ep)
+	if err != nil {
+		return fmt.Errorf("Failed to create endpoints: %s", err)
+	}
+	log.Printf("[INFO] Submitted new endpoints: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointsRead(d, meta)
+}
+
+func resourceKubernetesEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading endpoints %s", name)
+	ep, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received endpoints: %#v", ep)
+	err = d.Set("metadata", flattenMetadata(ep.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	flattened := flattenEndpointSubsets(ep.Subsets)
+	log.Printf("[DEBUG] Flattened endpoints subset: %#v", flattened)
+	err = d.Set("subset", flattened)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesEndpointsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("subset") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/subsets",
+			Value: expandEndpointSubsets(d.Get("subset").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating endpoints %q: %v", name, string(data))
+	out, err := conn.CoreV1().Endpoints(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update endpoints: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated endpoints: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointsRead(d, meta)
+}
+
+func resourceKubernetesEndpointsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting endpoints: %#v", name)
+	err = conn.CoreV1().Endpoints(namespace).Delete(name, &meta_v1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Endpoints %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesEndpointsExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking endpoints %s", name)
+	_, err = conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// resourceKubernetesEndpointsCustomizeDiff checks the configured port names
+// against the ports of the Service the endpoints belong to. The check is
+// skipped when the Service does not exist yet, e.g. when both are created in
+// the same run, or when the name is not known at plan time.
+func resourceKubernetesEndpointsCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	name := diff.Get("metadata.0.name").(string)
+	namespace := diff.Get("metadata.0.namespace").(string)
+	if name == "" {
+		return nil
+	}
+
+	conn := meta.(*kubernetesProvider).conn
+	svc, err := conn.CoreV1().Services(namespace).Get(name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			log.Printf("[DEBUG] Service %s/%s not found, skipping endpoints port validation", namespace, name)
+			return nil
+		}
+		return err
+	}
+
+	subsets := expandEndpointSubsets(diff.Get("subset").([]interface{}))
+	return validateEndpointPortsAgainstService(subsets, svc.Spec.Ports)
+}
+
+func validateEndpointPortsAgainstService(subsets []api.EndpointSubset, svcPorts []api.ServicePort) error {
+	names := make(map[string]bool, len(svcPorts))
+	for _, p := range svcPorts {
+		names[p.Name] = true
+	}
+
+	for i, s := range subsets {
+		for j, p := range s.Ports {
+			if p.Name == "" && len(svcPorts) > 1 {
+				return fmt.Errorf("subset.%d.port.%d.name must be set, the service defines more than one port", i, j)
+			}
+			if !names[p.Name] {
+				return fmt.Errorf("subset.%d.port.%d.name (%q) does not match any port name of the service", i, j, p.Name)
+			}
+		}
+	}
+	return nil
+}
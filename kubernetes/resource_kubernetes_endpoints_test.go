@@ -0,0 +1,268 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	api "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesEndpoints_basic(t *testing.T) {
+	var conf api.Endpoints
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_endpoints.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.TestLabelOne", "one"),
+					testAccCheckMetaLabels(&conf.ObjectMeta, map[string]string{"TestLabelOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_endpoints.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.address.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.address.0.ip", "10.0.0.4"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.port.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.port.0.name", "postgres"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.port.0.port", "5432"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.port.0.protocol", "TCP"),
+				),
+			},
+			{
+				Config: testAccKubernetesEndpointsConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointsExists("kubernetes_endpoints.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.annotations.%", "0"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "metadata.0.labels.%", "0"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.address.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.address.0.ip", "10.0.0.4"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.address.1.ip", "10.0.0.5"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.not_ready_address.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.not_ready_address.0.ip", "10.0.0.6"),
+					resource.TestCheckResourceAttr("kubernetes_endpoints.test", "subset.0.port.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesEndpoints_portNameMismatch(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_service(name),
+			},
+			{
+				Config:      testAccKubernetesEndpointsConfig_portNameMismatch(name),
+				ExpectError: regexp.MustCompile("does not match any port name of the service"),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesEndpoints_importBasic(t *testing.T) {
+	resourceName := "kubernetes_endpoints.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesEndpointsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointsConfig_basic(name),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestValidateEndpointPortsAgainstService(t *testing.T) {
+	svcPorts := []api.ServicePort{{Name: "http", Port: 80}, {Name: "https", Port: 443}}
+
+	validCases := [][]api.EndpointSubset{
+		{},
+		{{Ports: []api.EndpointPort{{Name: "http", Port: 8080}}}},
+		{{Ports: []api.EndpointPort{{Name: "http", Port: 8080}, {Name: "https", Port: 8443}}}},
+	}
+	for i, subsets := range validCases {
+		if err := validateEndpointPortsAgainstService(subsets, svcPorts); err != nil {
+			t.Fatalf("Expected case %d to be valid: %s", i, err)
+		}
+	}
+
+	invalidCases := [][]api.EndpointSubset{
+		{{Ports: []api.EndpointPort{{Port: 8080}}}},
+		{{Ports: []api.EndpointPort{{Name: "grpc", Port: 9090}}}},
+	}
+	for i, subsets := range invalidCases {
+		if err := validateEndpointPortsAgainstService(subsets, svcPorts); err == nil {
+			t.Fatalf("Expected case %d to be invalid", i)
+		}
+	}
+
+	singleUnnamed := []api.ServicePort{{Port: 5432}}
+	subsets := []api.EndpointSubset{{Ports: []api.EndpointPort{{Port: 5432}}}}
+	if err := validateEndpointPortsAgainstService(subsets, singleUnnamed); err != nil {
+		t.Fatalf("Expected unnamed port to match single unnamed service port: %s", err)
+	}
+}
+
+func testAccCheckKubernetesEndpointsDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_endpoints" {
+			continue
+		}
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Endpoints still exist: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesEndpointsExists(n string, obj *api.Endpoints) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		out, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesEndpointsConfig_service(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_service" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		port {
+			name        = "postgres"
+			port        = 5432
+			target_port = 5432
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_basic(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+	metadata {
+		name = "%s"
+		annotations {
+			TestAnnotationOne = "one"
+		}
+		labels {
+			TestLabelOne = "one"
+		}
+	}
+	subset {
+		address {
+			ip = "10.0.0.4"
+		}
+		port {
+			name = "postgres"
+			port = 5432
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_modified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+	metadata {
+		name = "%s"
+	}
+	subset {
+		address {
+			ip = "10.0.0.4"
+		}
+		address {
+			ip = "10.0.0.5"
+		}
+		not_ready_address {
+			ip = "10.0.0.6"
+		}
+		port {
+			name = "postgres"
+			port = 5432
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesEndpointsConfig_portNameMismatch(name string) string {
+	return testAccKubernetesEndpointsConfig_service(name) + fmt.Sprintf(`
+resource "kubernetes_endpoints" "test" {
+	metadata {
+		name = "%s"
+	}
+	subset {
+		address {
+			ip = "10.0.0.4"
+		}
+		port {
+			name = "mysql"
+			port = 3306
+		}
+	}
+}
+`, name)
+}
@@ -6,11 +6,16 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	api "k8s.io/api/autoscaling/v1"
+	"k8s.io/api/autoscaling/v2beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 )
 
+const horizontalPodAutoscalerResourceGroupName = "horizontalpodautoscalers"
+
+var horizontalPodAutoscalerAPIGroups = []APIGroup{autoscalingV2beta1, autoscalingV1}
+
 func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKubernetesHorizontalPodAutoscalerCreate,
@@ -36,6 +41,15 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 							Description: "Upper limit for the number of pods that can be set by the autoscaler.",
 							Required:    true,
 						},
+						"metric": {
+							Type:          schema.TypeList,
+							Description:   "The specifications for which to use to calculate the desired replica count. The desired replica count is calculated multiplying the ratio between the target value and the current value by the current number of pods. Requires the `autoscaling/v2beta1` API.",
+							Optional:      true,
+							ConflictsWith: []string{"spec.0.target_cpu_utilization_percentage"},
+							Elem: &schema.Resource{
+								Schema: horizontalPodAutoscalerMetricFields(),
+							},
+						},
 						"min_replicas": {
 							Type:        schema.TypeInt,
 							Description: "Lower limit for the number of pods that can be set by the autoscaler, defaults to `1`.",
@@ -48,80 +62,362 @@ func resourceKubernetesHorizontalPodAutoscaler() *schema.Resource {
 							Required:    true,
 							MaxItems:    1,
 							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"api_version": {
-										Type:        schema.TypeString,
-										Description: "API version of the referent",
-										Optional:    true,
-									},
-									"kind": {
-										Type:        schema.TypeString,
-										Description: "Kind of the referent. e.g. `ReplicationController`. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds",
-										Required:    true,
-									},
-									"name": {
-										Type:        schema.TypeString,
-										Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
-										Required:    true,
-									},
-								},
+								Schema: crossVersionObjectReferenceFields(),
 							},
 						},
 						"target_cpu_utilization_percentage": {
-							Type:        schema.TypeInt,
-							Description: "Target average CPU utilization (represented as a percentage of requested CPU) over all the pods. If not specified the default autoscaling policy will be used.",
-							Optional:    true,
-							Computed:    true,
+							Type:          schema.TypeInt,
+							Description:   "Target average CPU utilization (represented as a percentage of requested CPU) over all the pods. If not specified the default autoscaling policy will be used.",
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"spec.0.metric"},
 						},
 					},
 				},
 			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Current information about the autoscaler.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: horizontalPodAutoscalerStatusFields(),
+				},
+			},
 		},
 	}
 }
 
-func resourceKubernetesHorizontalPodAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+func horizontalPodAutoscalerMetricFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Description:  "The type of metric source. One of `Resource`, `Pods`, `Object` or `External`. It must match the metric source block that is set.",
+			Required:     true,
+			ValidateFunc: validateAttributeValueIsIn([]string{"Resource", "Pods", "Object", "External"}),
+		},
+		"external": {
+			Type:        schema.TypeList,
+			Description: "A global metric that is not associated with any Kubernetes object, e.g. the length of a queue in a hosted messaging service.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metric_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the metric in question.",
+						Required:    true,
+					},
+					"metric_selector": {
+						Type:        schema.TypeList,
+						Description: "A label selector used to identify a specific time series within the given metric.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: labelSelectorFields(true),
+						},
+					},
+					"target_average_value": {
+						Type:             schema.TypeString,
+						Description:      "The target per-pod value of the global metric. Mutually exclusive with `target_value`.",
+						Optional:         true,
+						ValidateFunc:     validateResourceQuantity,
+						DiffSuppressFunc: suppressEquivalentResourceQuantity,
+					},
+					"target_value": {
+						Type:             schema.TypeString,
+						Description:      "The target value of the metric. Mutually exclusive with `target_average_value`.",
+						Optional:         true,
+						ValidateFunc:     validateResourceQuantity,
+						DiffSuppressFunc: suppressEquivalentResourceQuantity,
+					},
+				},
+			},
+		},
+		"object": {
+			Type:        schema.TypeList,
+			Description: "A metric describing a single Kubernetes object, e.g. hits-per-second on an Ingress object.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metric_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the metric in question.",
+						Required:    true,
+					},
+					"target": {
+						Type:        schema.TypeList,
+						Description: "The described Kubernetes object.",
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: crossVersionObjectReferenceFields(),
+						},
+					},
+					"target_value": {
+						Type:             schema.TypeString,
+						Description:      "The target value of the metric.",
+						Required:         true,
+						ValidateFunc:     validateResourceQuantity,
+						DiffSuppressFunc: suppressEquivalentResourceQuantity,
+					},
+				},
+			},
+		},
+		"pods": {
+			Type:        schema.TypeList,
+			Description: "A metric describing each pod in the current scale target, e.g. transactions-processed-per-second. The values will be averaged together before being compared to the target value.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"metric_name": {
+						Type:        schema.TypeString,
+						Description: "The name of the metric in question.",
+						Required:    true,
+					},
+					"target_average_value": {
+						Type:             schema.TypeString,
+						Description:      "The target value of the average of the metric across all relevant pods.",
+						Required:         true,
+						ValidateFunc:     validateResourceQuantity,
+						DiffSuppressFunc: suppressEquivalentResourceQuantity,
+					},
+				},
+			},
+		},
+		"resource": {
+			Type:        schema.TypeList,
+			Description: "A resource metric (such as CPU or memory) known to Kubernetes, as specified in requests and limits, describing each pod in the current scale target.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Description:  "The name of the resource in question. One of `cpu` or `memory`.",
+						Required:     true,
+						ValidateFunc: validateAttributeValueIsIn([]string{"cpu", "memory"}),
+					},
+					"target_average_utilization": {
+						Type:        schema.TypeInt,
+						Description: "The target value of the average of the resource metric across all relevant pods, represented as a percentage of the requested value of the resource for the pods. Mutually exclusive with `target_average_value`.",
+						Optional:    true,
+					},
+					"target_average_value": {
+						Type:             schema.TypeString,
+						Description:      "The target value of the average of the resource metric across all relevant pods, as a raw value. Mutually exclusive with `target_average_utilization`.",
+						Optional:         true,
+						ValidateFunc:     validateResourceQuantity,
+						DiffSuppressFunc: suppressEquivalentResourceQuantity,
+					},
+				},
+			},
+		},
+	}
+}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
-	svc := api.HorizontalPodAutoscaler{
-		ObjectMeta: metadata,
-		Spec:       expandHorizontalPodAutoscalerSpec(d.Get("spec").([]interface{})),
+func crossVersionObjectReferenceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"api_version": {
+			Type:        schema.TypeString,
+			Description: "API version of the referent",
+			Optional:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "Kind of the referent. e.g. `ReplicationController`. More info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds",
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+			Required:    true,
+		},
 	}
-	log.Printf("[INFO] Creating new horizontal pod autoscaler: %#v", svc)
-	out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(metadata.Namespace).Create(&svc)
+}
+
+func horizontalPodAutoscalerStatusFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"condition": {
+			Type:        schema.TypeList,
+			Description: "Conditions needed for this autoscaler to scale its target, and whether or not those conditions are met. Only reported by the `autoscaling/v2beta1` API.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"last_transition_time": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"reason": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"current_metric": {
+			Type:        schema.TypeList,
+			Description: "The last read state of the metrics used by this autoscaler.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"current_average_utilization": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"current_average_value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"current_value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"metric_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"current_replicas": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"desired_replicas": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"last_scale_time": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func resourceKubernetesHorizontalPodAutoscalerCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	apiGroup, err := kp.highestSupportedAPIGroup(horizontalPodAutoscalerResourceGroupName, horizontalPodAutoscalerAPIGroups...)
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[INFO] Submitted new horizontal pod autoscaler: %#v", out)
-	d.SetId(buildId(out.ObjectMeta))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	switch apiGroup {
+	case autoscalingV2beta1:
+		spec, err := expandHorizontalPodAutoscalerV2beta1Spec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return err
+		}
+		svc := v2beta1.HorizontalPodAutoscaler{
+			ObjectMeta: metadata,
+			Spec:       spec,
+		}
+		log.Printf("[INFO] Creating new horizontal pod autoscaler: %#v", svc)
+		out, err := conn.AutoscalingV2beta1().HorizontalPodAutoscalers(metadata.Namespace).Create(&svc)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Submitted new horizontal pod autoscaler: %#v", out)
+		d.SetId(buildId(out.ObjectMeta))
+
+	default:
+		if err := horizontalPodAutoscalerMetricsSupported(d); err != nil {
+			return err
+		}
+		svc := api.HorizontalPodAutoscaler{
+			ObjectMeta: metadata,
+			Spec:       expandHorizontalPodAutoscalerSpec(d.Get("spec").([]interface{})),
+		}
+		log.Printf("[INFO] Creating new horizontal pod autoscaler: %#v", svc)
+		out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(metadata.Namespace).Create(&svc)
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Submitted new horizontal pod autoscaler: %#v", out)
+		d.SetId(buildId(out.ObjectMeta))
+	}
 
 	return resourceKubernetesHorizontalPodAutoscalerRead(d, meta)
 }
 
 func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
+
+	apiGroup, err := kp.highestSupportedAPIGroup(horizontalPodAutoscalerResourceGroupName, horizontalPodAutoscalerAPIGroups...)
+	if err != nil {
+		return err
+	}
+
+	var objectMeta meta_v1.ObjectMeta
+	var spec, status []interface{}
+
 	log.Printf("[INFO] Reading horizontal pod autoscaler %s", name)
-	svc, err := conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(name, meta_v1.GetOptions{})
+	switch apiGroup {
+	case autoscalingV2beta1:
+		svc, err := conn.AutoscalingV2beta1().HorizontalPodAutoscalers(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return err
+		}
+		log.Printf("[INFO] Received horizontal pod autoscaler: %#v", svc)
+		objectMeta = svc.ObjectMeta
+		spec = flattenHorizontalPodAutoscalerV2beta1Spec(svc.Spec, d)
+		status = flattenHorizontalPodAutoscalerV2beta1Status(svc.Status)
+
+	default:
+		svc, err := conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return err
+		}
+		log.Printf("[INFO] Received horizontal pod autoscaler: %#v", svc)
+		objectMeta = svc.ObjectMeta
+		spec = flattenHorizontalPodAutoscalerSpec(svc.Spec)
+		status = flattenHorizontalPodAutoscalerStatus(svc.Status)
+	}
+
+	err = d.Set("metadata", flattenMetadata(objectMeta, d))
 	if err != nil {
-		log.Printf("[DEBUG] Received error: %#v", err)
 		return err
 	}
-	log.Printf("[INFO] Received horizontal pod autoscaler: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+
+	log.Printf("[DEBUG] Flattened horizontal pod autoscaler spec: %#v", spec)
+	err = d.Set("spec", spec)
 	if err != nil {
 		return err
 	}
 
-	flattened := flattenHorizontalPodAutoscalerSpec(svc.Spec)
-	log.Printf("[DEBUG] Flattened horizontal pod autoscaler spec: %#v", flattened)
-	err = d.Set("spec", flattened)
+	err = d.Set("status", status)
 	if err != nil {
 		return err
 	}
@@ -130,33 +426,75 @@ func resourceKubernetesHorizontalPodAutoscalerRead(d *schema.ResourceData, meta
 }
 
 func resourceKubernetesHorizontalPodAutoscalerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*kubernetesProvider).conn
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
 
 	namespace, name, err := idParts(d.Id())
 	if err != nil {
 		return err
 	}
 
+	apiGroup, err := kp.highestSupportedAPIGroup(horizontalPodAutoscalerResourceGroupName, horizontalPodAutoscalerAPIGroups...)
+	if err != nil {
+		return err
+	}
+
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 	if d.HasChange("spec") {
-		diffOps := patchHorizontalPodAutoscalerSpec("spec.0.", "/spec", d)
-		ops = append(ops, diffOps...)
+		switch apiGroup {
+		case autoscalingV2beta1:
+			spec, err := expandHorizontalPodAutoscalerV2beta1Spec(d.Get("spec").([]interface{}))
+			if err != nil {
+				return err
+			}
+			ops = append(ops, &ReplaceOperation{
+				Path:  "/spec",
+				Value: spec,
+			})
+		default:
+			if err := horizontalPodAutoscalerMetricsSupported(d); err != nil {
+				return err
+			}
+			diffOps := patchHorizontalPodAutoscalerSpec("spec.0.", "/spec", d)
+			ops = append(ops, diffOps...)
+		}
 	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
 	}
 	log.Printf("[INFO] Updating horizontal pod autoscaler %q: %v", name, string(data))
-	out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Patch(name, pkgApi.JSONPatchType, data)
-	if err != nil {
-		return fmt.Errorf("Failed to update horizontal pod autoscaler: %s", err)
+
+	switch apiGroup {
+	case autoscalingV2beta1:
+		out, err := conn.AutoscalingV2beta1().HorizontalPodAutoscalers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		if err != nil {
+			return fmt.Errorf("Failed to update horizontal pod autoscaler: %s", err)
+		}
+		log.Printf("[INFO] Submitted updated horizontal pod autoscaler: %#v", out)
+		d.SetId(buildId(out.ObjectMeta))
+
+	default:
+		out, err := conn.AutoscalingV1().HorizontalPodAutoscalers(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		if err != nil {
+			return fmt.Errorf("Failed to update horizontal pod autoscaler: %s", err)
+		}
+		log.Printf("[INFO] Submitted updated horizontal pod autoscaler: %#v", out)
+		d.SetId(buildId(out.ObjectMeta))
 	}
-	log.Printf("[INFO] Submitted updated horizontal pod autoscaler: %#v", out)
-	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesHorizontalPodAutoscalerRead(d, meta)
 }
 
+// horizontalPodAutoscalerMetricsSupported returns an error when metrics are
+// configured but the server only supports the autoscaling/v1 API.
+func horizontalPodAutoscalerMetricsSupported(d *schema.ResourceData) error {
+	if metrics, ok := d.Get("spec.0.metric").([]interface{}); ok && len(metrics) > 0 {
+		return fmt.Errorf("spec.0.metric requires the %s API which is not supported by the Kubernetes server", autoscalingV2beta1)
+	}
+	return nil
+}
+
 func resourceKubernetesHorizontalPodAutoscalerDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*kubernetesProvider).conn
 
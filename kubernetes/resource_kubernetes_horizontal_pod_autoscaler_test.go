@@ -97,6 +97,100 @@ func TestAccKubernetesHorizontalPodAutoscaler_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesHorizontalPodAutoscaler_metrics(t *testing.T) {
+	var conf api.HorizontalPodAutoscaler
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_horizontal_pod_autoscaler.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesHorizontalPodAutoscalerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesHorizontalPodAutoscalerConfig_metrics(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHorizontalPodAutoscalerExists("kubernetes_horizontal_pod_autoscaler.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.#", "3"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.0.type", "Resource"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.0.resource.0.name", "cpu"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.0.resource.0.target_average_utilization", "50"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.1.type", "Resource"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.1.resource.0.name", "memory"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.1.resource.0.target_average_value", "512Mi"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.2.type", "Pods"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.2.pods.0.metric_name", "packets-per-second"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.2.pods.0.target_average_value", "1k"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "status.#", "1"),
+				),
+			},
+			{
+				Config: testAccKubernetesHorizontalPodAutoscalerConfig_metricsModified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHorizontalPodAutoscalerExists("kubernetes_horizontal_pod_autoscaler.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.0.type", "Object"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.0.object.0.metric_name", "requests-per-second"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.0.object.0.target.0.kind", "Ingress"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.0.object.0.target_value", "2k"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.1.type", "External"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.1.external.0.metric_name", "queue_messages_ready"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.1.external.0.metric_selector.0.match_labels.queue", "worker_tasks"),
+					resource.TestCheckResourceAttr("kubernetes_horizontal_pod_autoscaler.test", "spec.0.metric.1.external.0.target_average_value", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandHorizontalPodAutoscalerMetrics(t *testing.T) {
+	invalid := [][]interface{}{
+		{
+			map[string]interface{}{"type": "Resource"},
+		},
+		{
+			map[string]interface{}{
+				"type": "Resource",
+				"resource": []interface{}{
+					map[string]interface{}{"name": "cpu", "target_average_utilization": 50, "target_average_value": "100m"},
+				},
+			},
+		},
+		{
+			map[string]interface{}{
+				"type": "External",
+				"external": []interface{}{
+					map[string]interface{}{"metric_name": "queue_length", "target_value": "", "target_average_value": ""},
+				},
+			},
+		},
+	}
+	for i, metrics := range invalid {
+		if _, err := expandHorizontalPodAutoscalerMetrics(metrics); err == nil {
+			t.Fatalf("Expected case %d to be invalid", i)
+		}
+	}
+
+	valid := []interface{}{
+		map[string]interface{}{
+			"type": "Resource",
+			"resource": []interface{}{
+				map[string]interface{}{"name": "memory", "target_average_utilization": 0, "target_average_value": "512Mi"},
+			},
+		},
+	}
+	out, err := expandHorizontalPodAutoscalerMetrics(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out[0].Resource.TargetAverageValue.String() != "512Mi" {
+		t.Fatalf("Unexpected target average value: %s", out[0].Resource.TargetAverageValue.String())
+	}
+	if out[0].Resource.TargetAverageUtilization != nil {
+		t.Fatal("Expected target average utilization to be unset")
+	}
+}
+
 func TestAccKubernetesHorizontalPodAutoscaler_generatedName(t *testing.T) {
 	var conf api.HorizontalPodAutoscaler
 	prefix := "tf-acc-test-"
@@ -269,6 +363,85 @@ resource "kubernetes_horizontal_pod_autoscaler" "test" {
 `, name)
 }
 
+func testAccKubernetesHorizontalPodAutoscalerConfig_metrics(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_horizontal_pod_autoscaler" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		max_replicas = 10
+		scale_target_ref {
+			kind = "ReplicationController"
+			name = "TerraformAccTest"
+		}
+		metric {
+			type = "Resource"
+			resource {
+				name                       = "cpu"
+				target_average_utilization = 50
+			}
+		}
+		metric {
+			type = "Resource"
+			resource {
+				name                 = "memory"
+				target_average_value = "512Mi"
+			}
+		}
+		metric {
+			type = "Pods"
+			pods {
+				metric_name          = "packets-per-second"
+				target_average_value = "1k"
+			}
+		}
+	}
+}
+`, name)
+}
+
+func testAccKubernetesHorizontalPodAutoscalerConfig_metricsModified(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_horizontal_pod_autoscaler" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		max_replicas = 10
+		scale_target_ref {
+			kind = "ReplicationController"
+			name = "TerraformAccTest"
+		}
+		metric {
+			type = "Object"
+			object {
+				metric_name  = "requests-per-second"
+				target_value = "2k"
+				target {
+					api_version = "extensions/v1beta1"
+					kind        = "Ingress"
+					name        = "main-route"
+				}
+			}
+		}
+		metric {
+			type = "External"
+			external {
+				metric_name = "queue_messages_ready"
+				metric_selector {
+					match_labels {
+						queue = "worker_tasks"
+					}
+				}
+				target_average_value = "30"
+			}
+		}
+	}
+}
+`, name)
+}
+
 func testAccKubernetesHorizontalPodAutoscalerConfig_generatedName(prefix string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_horizontal_pod_autoscaler" "test" {
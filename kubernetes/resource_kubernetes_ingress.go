@@ -2,9 +2,11 @@ package kubernetes
 
 import (
 	"log"
+	"time"
 
 	"fmt"
 
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -19,7 +21,14 @@ func resourceKubernetesIngress() *schema.Resource {
 		Update: resourceKubernetesIngressUpdate,
 		Delete: resourceKubernetesIngressDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_load_balancer", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,6 +103,12 @@ func resourceKubernetesIngress() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for the ingress controller to assign an IP or hostname to the ingress on creation. Defaults to `false`.",
+				Optional:    true,
+				Default:     false,
+			},
 			"load_balancer_ingress": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -130,6 +145,33 @@ func resourceKubernetesIngressCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Submitted new ingress: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
+	if d.Get("wait_for_load_balancer").(bool) {
+		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
+
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			ing, err := conn.ExtensionsV1beta1().Ingresses(out.Namespace).Get(out.Name, meta_v1.GetOptions{})
+			if err != nil {
+				log.Printf("[DEBUG] Received error: %#v", err)
+				return resource.NonRetryableError(err)
+			}
+
+			log.Printf("[INFO] Received ingress status: %#v", ing.Status)
+			if len(ing.Status.LoadBalancer.Ingress) > 0 {
+				return nil
+			}
+
+			return resource.RetryableError(fmt.Errorf(
+				"Waiting for ingress %q to assign IP/hostname for a load balancer", d.Id()))
+		})
+		if err != nil {
+			lastWarnings, wErr := getLastWarningsForObject(conn, out.ObjectMeta, "Ingress", 3)
+			if wErr != nil {
+				return wErr
+			}
+			return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		}
+	}
+
 	return resourceKubernetesIngressRead(d, meta)
 }
 
@@ -26,6 +26,7 @@ func TestAccKubernetesIngress_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKubernetesIngressExists("kubernetes_ingress.test", &conf),
 					resource.TestCheckResourceAttr("kubernetes_ingress.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_ingress.test", "wait_for_load_balancer", "false"),
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.generation"),
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.resource_version"),
 					resource.TestCheckResourceAttrSet("kubernetes_ingress.test", "metadata.0.self_link"),
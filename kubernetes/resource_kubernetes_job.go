@@ -7,21 +7,33 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesJob() *schema.Resource {
 	s := &schema.Resource{
 		Create: resourceKubernetesJobCreate,
 		Read:   resourceKubernetesJobRead,
-		// Update: resourceKubernetesJobUpdate,
+		Update: resourceKubernetesJobUpdate,
 		Delete: resourceKubernetesJobDelete,
 		Exists: resourceKubernetesJobExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_completion", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		SchemaVersion: 1,
+		MigrateState:  resourceKubernetesJobStateUpgrader,
+		CustomizeDiff: podSpecBlockVolumesCustomizeDiff("spec.0.template.0.spec", ""),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("job", true),
@@ -35,6 +47,13 @@ func resourceKubernetesJob() *schema.Resource {
 					Schema: jobSpecFields(),
 				},
 			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for the job to complete on creation, failing if the job fails. The wait is bounded by the `create` timeout.",
+				Optional:    true,
+				Default:     false,
+			},
+			"status": jobStatusSchema(),
 		},
 	}
 
@@ -65,6 +84,20 @@ func resourceKubernetesJobCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(buildId(out.ObjectMeta))
 
+	if d.Get("wait_for_completion").(bool) {
+		log.Printf("[DEBUG] Waiting for job %s to complete", d.Id())
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate),
+			waitForJobCompletionFunc(conn, out.Namespace, out.Name))
+		if err != nil {
+			lastWarnings, wErr := getLastWarningsForObject(conn, out.ObjectMeta, "Job", 3)
+			if wErr != nil {
+				return wErr
+			}
+			return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		}
+		log.Printf("[INFO] Job %s completed", d.Id())
+	}
+
 	return resourceKubernetesJobRead(d, meta)
 }
 
@@ -180,6 +213,11 @@ func resourceKubernetesJobRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	err = d.Set("status", flattenJobStatus(job.Status))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -237,3 +275,58 @@ func resourceKubernetesJobExists(d *schema.ResourceData, meta interface{}) (bool
 	}
 	return true, err
 }
+
+func waitForJobCompletionFunc(conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		job, err := conn.BatchV1().Jobs(ns).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, c := range job.Status.Conditions {
+			if c.Status != v1.ConditionTrue {
+				continue
+			}
+			switch c.Type {
+			case batchv1.JobComplete:
+				return nil
+			case batchv1.JobFailed:
+				msg := fmt.Sprintf("Job %q failed: %s: %s", name, c.Reason, c.Message)
+				pods, err := conn.CoreV1().Pods(ns).List(metav1.ListOptions{
+					LabelSelector: metav1.FormatLabelSelector(job.Spec.Selector),
+				})
+				if err != nil {
+					return resource.NonRetryableError(fmt.Errorf("%s (failed to list pods: %s)", msg, err))
+				}
+				for _, m := range failedJobPodMessages(pods.Items, 3) {
+					msg += "\n   * " + m
+				}
+				return resource.NonRetryableError(fmt.Errorf("%s", msg))
+			}
+		}
+
+		return resource.RetryableError(fmt.Errorf("Waiting for job %q to complete (%d active, %d succeeded, %d failed)",
+			name, job.Status.Active, job.Status.Succeeded, job.Status.Failed))
+	}
+}
+
+func resourceKubernetesJobStateUpgrader(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	var err error
+
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Kubernetes Job State v0; migrating to v1")
+		is, err = migrateContainerResourcesState(is)
+
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+
+	return is, err
+}
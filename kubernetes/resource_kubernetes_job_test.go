@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -53,6 +54,48 @@ func TestAccKubernetesJob_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesJob_waitForCompletion(t *testing.T) {
+	var conf api.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_job.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobConfig_waitForCompletion(name, "exit 0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "status.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "status.0.succeeded", "1"),
+					resource.TestCheckResourceAttr("kubernetes_job.test", "status.0.failed", "0"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "status.0.start_time"),
+					resource.TestCheckResourceAttrSet("kubernetes_job.test", "status.0.completion_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJob_waitForCompletionFailure(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesJobConfig_waitForCompletion(name, "echo 'migration failed' > /dev/termination-log; exit 1"),
+				ExpectError: regexp.MustCompile("BackoffLimitExceeded(.|\\n)*migration failed"),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesJobDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*kubernetesProvider).conn
 
@@ -149,3 +192,26 @@ resource "kubernetes_job" "test" {
 	}
 }`, name)
 }
+
+func testAccKubernetesJobConfig_waitForCompletion(name, script string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_job" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		backoff_limit = 0
+		template {
+			spec {
+				container {
+					name = "migrate"
+					image = "alpine"
+					command = ["sh", "-c", "%s"]
+				}
+				restart_policy = "Never"
+			}
+		}
+	}
+	wait_for_completion = true
+}`, name, script)
+}
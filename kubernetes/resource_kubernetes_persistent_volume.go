@@ -109,6 +109,71 @@ func resourceKubernetesPersistentVolume() *schema.Resource {
 							Description: "A description of the persistent volume's class. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#class",
 							Optional:    true,
 						},
+						"volume_mode": {
+							Type:         schema.TypeString,
+							Description:  "Defines if a volume is intended to be used with a formatted filesystem or to remain in raw block state. One of `Filesystem` or `Block`. Defaults to `Filesystem`.",
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAttributeValueIsIn([]string{"Filesystem", "Block"}),
+						},
+						"node_affinity": {
+							Type:        schema.TypeList,
+							Description: "A description of the persistent volume's node affinity. More info: https://kubernetes.io/docs/concepts/storage/volumes/#local",
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"required": {
+										Type:        schema.TypeList,
+										Description: "Required specifies hard node constraints that must be met.",
+										Required:    true,
+										ForceNew:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: forceNewFields(nodeSelectorFields()),
+										},
+									},
+								},
+							},
+						},
+						"claim_ref": {
+							Type:        schema.TypeList,
+							Description: "A reference to the persistent volume claim this volume is bound to. Setting it pre-binds the volume to the given claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#binding",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the persistent volume claim.",
+										Required:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "The namespace of the persistent volume claim.",
+										Optional:    true,
+										Default:     "default",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Current information about the persistent volume.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phase": {
+							Type:        schema.TypeString,
+							Description: "The phase of the persistent volume, one of Pending, Available, Bound, Released or Failed. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#phase",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -182,6 +247,10 @@ func resourceKubernetesPersistentVolumeRead(d *schema.ResourceData, meta interfa
 	if err != nil {
 		return err
 	}
+	err = d.Set("status", flattenPersistentVolumeStatus(volume.Status))
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
@@ -27,8 +28,11 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceKubernetesPersistentVolumeClaimCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: persistentVolumeClaimSpecFields(false),
@@ -139,7 +143,17 @@ func resourceKubernetesPersistentVolumeClaimUpdate(d *schema.ResourceData, meta
 	}
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
-	// The whole spec is ForceNew = nothing to update there
+	// Only storage increases reach this point, everything else in the spec is ForceNew
+	if d.HasChange("spec.0.resources.0.requests") {
+		requests, err := expandMapToResourceList(d.Get("spec.0.resources.0.requests").(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/resources/requests",
+			Value: requests,
+		})
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
@@ -152,6 +166,13 @@ func resourceKubernetesPersistentVolumeClaimUpdate(d *schema.ResourceData, meta
 	}
 	log.Printf("[INFO] Submitted updated persistent volume claim: %#v", out)
 
+	if d.HasChange("spec.0.resources.0.requests") {
+		err = waitForPersistentVolumeClaimResize(conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesPersistentVolumeClaimRead(d, meta)
 }
 
@@ -193,3 +214,117 @@ func resourceKubernetesPersistentVolumeClaimExists(d *schema.ResourceData, meta
 	}
 	return true, err
 }
+
+// waitForPersistentVolumeClaimResize waits until the capacity of the claim
+// reaches the requested storage, or until only the file system resize is left
+// which happens on the node once a pod uses the claim.
+func waitForPersistentVolumeClaimResize(conn *kubernetes.Clientset, namespace, name string, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		claim, err := conn.CoreV1().PersistentVolumeClaims(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		for _, c := range claim.Status.Conditions {
+			if c.Type == api.PersistentVolumeClaimFileSystemResizePending && c.Status == api.ConditionTrue {
+				log.Printf("[INFO] Persistent volume claim %s resized, file system resize pending", name)
+				return nil
+			}
+		}
+
+		requested := claim.Spec.Resources.Requests[api.ResourceStorage]
+		capacity := claim.Status.Capacity[api.ResourceStorage]
+		if capacity.Cmp(requested) >= 0 {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Waiting for persistent volume claim %s to be resized (capacity %s, requested %s)",
+			name, capacity.String(), requested.String()))
+	})
+}
+
+// resourceKubernetesPersistentVolumeClaimCustomizeDiff allows the requested
+// storage to grow in place when the claim's storage class allows volume
+// expansion. Any other change to the requests still forces a new claim, and
+// shrinking the requested storage is refused as Kubernetes does not support it.
+func resourceKubernetesPersistentVolumeClaimCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("spec.0.resources.0.requests") {
+		return nil
+	}
+
+	o, n := diff.GetChange("spec.0.resources.0.requests")
+	oldRequests, err := expandMapToResourceList(o.(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	newRequests, err := expandMapToResourceList(n.(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if resourceListEqual(oldRequests, newRequests) {
+		// Only the notation changed, e.g. from 1Gi to 1024Mi, which the
+		// API server accepts as an update of the claim
+		return nil
+	}
+
+	resizable, err := isPersistentVolumeClaimResize(oldRequests, newRequests)
+	if err != nil {
+		return err
+	}
+	if !resizable {
+		return diff.ForceNew("spec.0.resources.0.requests")
+	}
+
+	storageClassName := diff.Get("spec.0.storage_class_name").(string)
+	if storageClassName == "" {
+		return diff.ForceNew("spec.0.resources.0.requests")
+	}
+	conn := meta.(*kubernetesProvider).conn
+	sc, err := conn.StorageV1().StorageClasses().Get(storageClassName, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return diff.ForceNew("spec.0.resources.0.requests")
+		}
+		return err
+	}
+	if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
+		log.Printf("[DEBUG] Storage class %s does not allow volume expansion, recreating claim", storageClassName)
+		return diff.ForceNew("spec.0.resources.0.requests")
+	}
+
+	return nil
+}
+
+// isPersistentVolumeClaimResize reports whether going from the old to the new
+// requests only grows the requested storage, which is the one change that can
+// be applied to an existing claim. Shrinking the requested storage is an error.
+func isPersistentVolumeClaimResize(oldRequests, newRequests api.ResourceList) (bool, error) {
+	oldStorage, oldOk := oldRequests[api.ResourceStorage]
+	newStorage, newOk := newRequests[api.ResourceStorage]
+	oldOthers := oldRequests.DeepCopy()
+	newOthers := newRequests.DeepCopy()
+	delete(oldOthers, api.ResourceStorage)
+	delete(newOthers, api.ResourceStorage)
+	if !oldOk || !newOk || !resourceListEqual(oldOthers, newOthers) {
+		return false, nil
+	}
+
+	if newStorage.Cmp(oldStorage) < 0 {
+		return false, fmt.Errorf("spec.0.resources.0.requests.storage: shrinking a persistent volume claim is not supported (%s -> %s)",
+			oldStorage.String(), newStorage.String())
+	}
+	return true, nil
+}
+
+func resourceListEqual(a, b api.ResourceList) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		w, ok := b[k]
+		if !ok || v.Cmp(w) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -5,14 +5,205 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	api "k8s.io/api/core/v1"
 	storageapi "k8s.io/api/storage/v1"
+	apiResource "k8s.io/apimachinery/pkg/api/resource"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+func TestResourceListEqual(t *testing.T) {
+	testCases := []struct {
+		A, B     api.ResourceList
+		Expected bool
+	}{
+		{api.ResourceList{}, api.ResourceList{}, true},
+		{
+			api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			api.ResourceList{api.ResourceStorage: apiResource.MustParse("1024Mi")},
+			true,
+		},
+		{
+			api.ResourceList{api.ResourceCPU: apiResource.MustParse("500m")},
+			api.ResourceList{api.ResourceCPU: apiResource.MustParse("0.5")},
+			true,
+		},
+		{
+			api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			api.ResourceList{api.ResourceStorage: apiResource.MustParse("1G")},
+			false,
+		},
+		{
+			api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			api.ResourceList{api.ResourceMemory: apiResource.MustParse("1Gi")},
+			false,
+		},
+		{
+			api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			api.ResourceList{
+				api.ResourceStorage: apiResource.MustParse("1Gi"),
+				api.ResourceMemory:  apiResource.MustParse("1Gi"),
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		if out := resourceListEqual(tc.A, tc.B); out != tc.Expected {
+			t.Fatalf("%d: expected %t, got %t", i, tc.Expected, out)
+		}
+	}
+}
+
+func TestIsPersistentVolumeClaimResize(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Old, New  api.ResourceList
+		Expected  bool
+		ExpectErr bool
+	}{
+		{
+			Name:     "grow",
+			Old:      api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			New:      api.ResourceList{api.ResourceStorage: apiResource.MustParse("2Gi")},
+			Expected: true,
+		},
+		{
+			Name:     "same size in another format",
+			Old:      api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			New:      api.ResourceList{api.ResourceStorage: apiResource.MustParse("1024Mi")},
+			Expected: true,
+		},
+		{
+			Name:      "shrink",
+			Old:       api.ResourceList{api.ResourceStorage: apiResource.MustParse("2Gi")},
+			New:       api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			ExpectErr: true,
+		},
+		{
+			Name: "grow with another request changed",
+			Old:  api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi"), api.ResourceMemory: apiResource.MustParse("1Gi")},
+			New:  api.ResourceList{api.ResourceStorage: apiResource.MustParse("2Gi"), api.ResourceMemory: apiResource.MustParse("2Gi")},
+		},
+		{
+			Name: "storage request added",
+			Old:  api.ResourceList{},
+			New:  api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+		},
+		{
+			Name: "storage request removed",
+			Old:  api.ResourceList{api.ResourceStorage: apiResource.MustParse("1Gi")},
+			New:  api.ResourceList{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			out, err := isPersistentVolumeClaimResize(tc.Old, tc.New)
+			if tc.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if out != tc.Expected {
+				t.Fatalf("expected %t, got %t", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestResourceKubernetesPersistentVolumeClaimCustomizeDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "default/test",
+		Attributes: map[string]string{
+			"id":                                  "default/test",
+			"metadata.#":                          "1",
+			"metadata.0.name":                     "test",
+			"metadata.0.namespace":                "default",
+			"spec.#":                              "1",
+			"spec.0.access_modes.#":               "1",
+			"spec.0.access_modes.1254135962":      "ReadWriteOnce",
+			"spec.0.resources.#":                  "1",
+			"spec.0.resources.0.requests.%":       "1",
+			"spec.0.resources.0.requests.storage": "1Gi",
+			"spec.0.storage_class_name":           "",
+			"wait_until_bound":                    "true",
+		},
+	}
+	raw := func(requests map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"metadata": []interface{}{
+				map[string]interface{}{"name": "test", "namespace": "default"},
+			},
+			"spec": []interface{}{
+				map[string]interface{}{
+					"access_modes": []interface{}{"ReadWriteOnce"},
+					"resources": []interface{}{
+						map[string]interface{}{"requests": requests},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name        string
+		Requests    map[string]interface{}
+		RequiresNew bool
+		ExpectErr   bool
+	}{
+		{
+			Name:     "same size in another format",
+			Requests: map[string]interface{}{"storage": "1024Mi"},
+		},
+		{
+			Name:      "shrink",
+			Requests:  map[string]interface{}{"storage": "512Mi"},
+			ExpectErr: true,
+		},
+		{
+			Name:        "grow without a storage class",
+			Requests:    map[string]interface{}{"storage": "2Gi"},
+			RequiresNew: true,
+		},
+		{
+			Name:        "another request added",
+			Requests:    map[string]interface{}{"storage": "1Gi", "memory": "1Gi"},
+			RequiresNew: true,
+		},
+	}
+
+	r := resourceKubernetesPersistentVolumeClaim()
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := config.NewRawConfig(raw(tc.Requests))
+			if err != nil {
+				t.Fatal(err)
+			}
+			diff, err := r.Diff(state, terraform.NewResourceConfig(c), nil)
+			if tc.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if requiresNew := diff != nil && diff.RequiresNew(); requiresNew != tc.RequiresNew {
+				t.Fatalf("expected RequiresNew to be %t, got %t: %#v", tc.RequiresNew, requiresNew, diff)
+			}
+		})
+	}
+}
+
 func TestAccKubernetesPersistentVolumeClaim_basic(t *testing.T) {
 	var conf api.PersistentVolumeClaim
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
@@ -371,6 +371,7 @@ func TestAccKubernetesPersistentVolume_cephFsSecretRef(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.persistent_volume_source.0.ceph_fs.0.monitors.4263435410", "10.16.154.82:6789"),
 					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.persistent_volume_source.0.ceph_fs.0.secret_ref.#", "1"),
 					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.persistent_volume_source.0.ceph_fs.0.secret_ref.0.name", "ceph-secret"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.persistent_volume_source.0.ceph_fs.0.secret_ref.0.namespace", "kube-system"),
 				),
 			},
 		},
@@ -437,6 +438,38 @@ func TestAccKubernetesPersistentVolume_storageClass(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPersistentVolume_local_nodeAffinityAndClaimRef(t *testing.T) {
+	var conf api.PersistentVolume
+	randString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	name := fmt.Sprintf("tf-acc-test-%s", randString)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_persistent_volume.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesPersistentVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeConfig_local_nodeAffinityAndClaimRef(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeExists("kubernetes_persistent_volume.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.persistent_volume_source.0.local.0.path", "/mnt/disks/ssd1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.node_affinity.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.node_affinity.0.required.0.node_selector_term.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.node_affinity.0.required.0.node_selector_term.0.match_expressions.0.key", "kubernetes.io/hostname"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.node_affinity.0.required.0.node_selector_term.0.match_expressions.0.operator", "In"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.node_affinity.0.required.0.node_selector_term.0.match_expressions.0.values.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.claim_ref.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.claim_ref.0.name", name),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "spec.0.claim_ref.0.namespace", "default"),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume.test", "status.0.phase", "Available"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesPersistentVolumeDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*kubernetesProvider).conn
 
@@ -701,7 +734,8 @@ resource "kubernetes_persistent_volume" "test" {
 			ceph_fs {
 				monitors = ["10.16.154.78:6789", "10.16.154.82:6789"]
 				secret_ref {
-					name = "ceph-secret"
+					name      = "ceph-secret"
+					namespace = "kube-system"
 				}
 			}
 		}
@@ -758,3 +792,39 @@ resource "kubernetes_storage_class" "test2" {
 }
 `, name, refName, diskName, zone, storageClassName, storageClassName2)
 }
+
+func testAccKubernetesPersistentVolumeConfig_local_nodeAffinityAndClaimRef(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_persistent_volume" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		capacity {
+			storage = "10Gi"
+		}
+		access_modes = ["ReadWriteOnce"]
+		persistent_volume_reclaim_policy = "Delete"
+		storage_class_name = "local-storage"
+		persistent_volume_source {
+			local {
+				path = "/mnt/disks/ssd1"
+			}
+		}
+		node_affinity {
+			required {
+				node_selector_term {
+					match_expressions {
+						key = "kubernetes.io/hostname"
+						operator = "In"
+						values = ["example-node"]
+					}
+				}
+			}
+		}
+		claim_ref {
+			name = "%s"
+		}
+	}
+}`, name, name)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -22,7 +23,16 @@ func resourceKubernetesPod() *schema.Resource {
 		Delete: resourceKubernetesPodDelete,
 		Exists: resourceKubernetesPodExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_state", podWaitRunning)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		SchemaVersion: 1,
+		MigrateState:  resourceKubernetesPodStateUpgrader,
+		CustomizeDiff: podSpecBlockVolumesCustomizeDiff("spec", ""),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("pod", true),
@@ -35,9 +45,24 @@ func resourceKubernetesPod() *schema.Resource {
 					Schema: podSpecFields(false),
 				},
 			},
+			"wait_for_state": {
+				Type:         schema.TypeString,
+				Description:  "The state to wait for the pod to reach on creation. One of `Running`, `Ready` (all containers passing their readiness probes) or `Succeeded` (for pods that run to completion). Defaults to `Running`.",
+				Optional:     true,
+				Default:      podWaitRunning,
+				ValidateFunc: validateAttributeValueIsIn([]string{podWaitRunning, podWaitReady, podWaitSucceeded}),
+			},
+			"status": podStatusSchema(),
 		},
 	}
 }
+
+const (
+	podWaitRunning   = "Running"
+	podWaitReady     = "Ready"
+	podWaitSucceeded = "Succeeded"
+)
+
 func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*kubernetesProvider).conn
 
@@ -62,23 +87,26 @@ func resourceKubernetesPodCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(buildId(out.ObjectMeta))
 
-	stateConf := &resource.StateChangeConf{
-		Target:  []string{"Running"},
-		Pending: []string{"Pending"},
-		Timeout: 5 * time.Minute,
-		Refresh: func() (interface{}, string, error) {
-			out, err := conn.CoreV1().Pods(metadata.Namespace).Get(metadata.Name, metav1.GetOptions{})
-			if err != nil {
-				log.Printf("[ERROR] Received error: %#v", err)
-				return out, "Error", err
-			}
+	target := d.Get("wait_for_state").(string)
+	log.Printf("[DEBUG] Waiting for pod %s to be %s", d.Id(), target)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		pod, err := conn.CoreV1().Pods(metadata.Namespace).Get(out.Name, metav1.GetOptions{})
+		if err != nil {
+			log.Printf("[ERROR] Received error: %#v", err)
+			return resource.NonRetryableError(err)
+		}
+		log.Printf("[DEBUG] Pods %s status received: %#v", pod.Name, pod.Status.Phase)
 
-			statusPhase := fmt.Sprintf("%v", out.Status.Phase)
-			log.Printf("[DEBUG] Pods %s status received: %#v", out.Name, statusPhase)
-			return out, statusPhase, nil
-		},
-	}
-	_, err = stateConf.WaitForState()
+		done, err := podReachedState(pod, target)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if done {
+			return nil
+		}
+		return resource.RetryableError(fmt.Errorf("Waiting for pod %q to be %s (currently %s)",
+			pod.Name, target, pod.Status.Phase))
+	})
 	if err != nil {
 		lastWarnings, wErr := getLastWarningsForObject(conn, out.ObjectMeta, "Pod", 3)
 		if wErr != nil {
@@ -184,6 +212,11 @@ func resourceKubernetesPodRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
+
+	err = d.Set("status", flattenPodStatus(pod.Status))
+	if err != nil {
+		return err
+	}
 	return nil
 
 }
@@ -244,6 +277,57 @@ func resourceKubernetesPodExists(d *schema.ResourceData, meta interface{}) (bool
 	return true, err
 }
 
+// podSpecBlockVolumesCustomizeDiff returns a CustomizeDiffFunc validating the
+// use of block volumes in the pod spec found at podSpecKey. Claims referenced by
+// pod volumes are looked up to find their volume mode and are skipped when
+// they do not exist yet. Claim templates found at claimTemplatesKey, if set,
+// are checked as configured.
+func podSpecBlockVolumesCustomizeDiff(podSpecKey, claimTemplatesKey string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		spec, err := expandPodSpec(diff.Get(podSpecKey).([]interface{}))
+		if err != nil {
+			return err
+		}
+		namespace := diff.Get("metadata.0.namespace").(string)
+
+		conn := meta.(*kubernetesProvider).conn
+		blockVolumes := make(map[string]bool)
+		for _, v := range spec.Volumes {
+			if v.PersistentVolumeClaim == nil {
+				blockVolumes[v.Name] = false
+				continue
+			}
+			claimName := v.PersistentVolumeClaim.ClaimName
+			if claimName == "" {
+				continue
+			}
+			claim, err := conn.CoreV1().PersistentVolumeClaims(namespace).Get(claimName, metav1.GetOptions{})
+			if err != nil {
+				if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+					log.Printf("[DEBUG] Persistent volume claim %s/%s not found, skipping volume mode validation", namespace, claimName)
+					continue
+				}
+				return err
+			}
+			blockVolumes[v.Name] = claim.Spec.VolumeMode != nil && *claim.Spec.VolumeMode == api.PersistentVolumeBlock
+		}
+
+		if claimTemplatesKey != "" {
+			for _, t := range diff.Get(claimTemplatesKey).([]interface{}) {
+				m := t.(map[string]interface{})
+				metadata := expandMetadata(m["metadata"].([]interface{}))
+				claimSpec, err := expandPersistentVolumeClaimSpec(m["spec"].([]interface{}))
+				if err != nil {
+					return err
+				}
+				blockVolumes[metadata.Name] = claimSpec.VolumeMode != nil && *claimSpec.VolumeMode == api.PersistentVolumeBlock
+			}
+		}
+
+		return validatePodSpecBlockVolumes(spec, blockVolumes)
+	}
+}
+
 func removeTokenVolumeMount(c api.Container, tokenName string) api.Container {
 	vmIndex := -1
 	for vi, v := range c.VolumeMounts {
@@ -258,3 +342,89 @@ func removeTokenVolumeMount(c api.Container, tokenName string) api.Container {
 	}
 	return c
 }
+
+// podReachedState reports whether the pod reached the given wait target.
+// It returns an error as soon as the pod failed or one of its containers is
+// crash looping, since the target can then no longer be reached.
+func podReachedState(pod *api.Pod, target string) (bool, error) {
+	if pod.Status.Phase == api.PodFailed {
+		msg := fmt.Sprintf("Pod %q failed", pod.Name)
+		if pod.Status.Reason != "" {
+			msg += fmt.Sprintf(": %s: %s", pod.Status.Reason, pod.Status.Message)
+		}
+		return false, fmt.Errorf("%s%s", msg, stringifyContainerTerminations(pod))
+	}
+	for _, cs := range podContainerStatuses(pod) {
+		if cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff" {
+			return false, fmt.Errorf("Container %q of pod %q is crash looping%s",
+				cs.Name, pod.Name, stringifyContainerTerminations(pod))
+		}
+	}
+
+	switch target {
+	case podWaitSucceeded:
+		return pod.Status.Phase == api.PodSucceeded, nil
+	case podWaitReady:
+		if pod.Status.Phase == api.PodSucceeded {
+			return false, fmt.Errorf("Pod %q completed before becoming ready", pod.Name)
+		}
+		if pod.Status.Phase != api.PodRunning {
+			return false, nil
+		}
+		for _, c := range pod.Status.Conditions {
+			if c.Type == api.PodReady {
+				return c.Status == api.ConditionTrue, nil
+			}
+		}
+		return false, nil
+	default:
+		return pod.Status.Phase == api.PodRunning || pod.Status.Phase == api.PodSucceeded, nil
+	}
+}
+
+func podContainerStatuses(pod *api.Pod) []api.ContainerStatus {
+	out := make([]api.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	out = append(out, pod.Status.InitContainerStatuses...)
+	return append(out, pod.Status.ContainerStatuses...)
+}
+
+// stringifyContainerTerminations describes the last unsuccessful termination
+// of each container in the pod.
+func stringifyContainerTerminations(pod *api.Pod) string {
+	var output string
+	for _, cs := range podContainerStatuses(pod) {
+		t := cs.State.Terminated
+		if t == nil {
+			t = cs.LastTerminationState.Terminated
+		}
+		if t == nil || t.ExitCode == 0 {
+			continue
+		}
+		output += fmt.Sprintf("\n   * %s (Container): %s: exit code %d", cs.Name, t.Reason, t.ExitCode)
+		if t.Message != "" {
+			output += ": " + t.Message
+		}
+	}
+	return output
+}
+
+func resourceKubernetesPodStateUpgrader(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	var err error
+
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Kubernetes Pod State v0; migrating to v1")
+		is, err = migrateContainerResourcesState(is)
+
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+
+	return is, err
+}
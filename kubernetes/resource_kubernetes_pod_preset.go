@@ -0,0 +1,198 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/settings/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesPodPreset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPodPresetCreate,
+		Read:   resourceKubernetesPodPresetRead,
+		Exists: resourceKubernetesPodPresetExists,
+		Update: resourceKubernetesPodPresetUpdate,
+		Delete: resourceKubernetesPodPresetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("pod preset", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the information to inject into pods matching the selector at creation time. The spec of a pod preset cannot be changed once created.",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: forceNewFields(map[string]*schema.Schema{
+						"selector": {
+							Type:        schema.TypeList,
+							Description: "A label query over the pods this preset applies to. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: labelSelectorFields(true),
+							},
+						},
+						"env": {
+							Type:        schema.TypeList,
+							Description: "List of environment variables to inject into the containers of matching pods.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: envFields(),
+							},
+						},
+						"env_from": {
+							Type:        schema.TypeList,
+							Description: "List of sources to populate environment variables in the containers of matching pods.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: envFromFields(),
+							},
+						},
+						"volume": {
+							Type:        schema.TypeList,
+							Description: "List of volumes to inject into matching pods. More info: http://kubernetes.io/docs/user-guide/volumes",
+							Optional:    true,
+							Elem:        volumeSchema(true),
+						},
+						"volume_mount": {
+							Type:        schema.TypeList,
+							Description: "List of volume mounts to inject into the containers of matching pods.",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: volumeMountFields(),
+							},
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesPodPresetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandPodPresetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+	preset := api.PodPreset{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+	log.Printf("[INFO] Creating new pod preset: %#v", preset)
+	out, err := conn.SettingsV1alpha1().PodPresets(metadata.Namespace).Create(&preset)
+	if err != nil {
+		return fmt.Errorf("Failed to create pod preset: %s", err)
+	}
+	log.Printf("[INFO] Submitted new pod preset: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodPresetRead(d, meta)
+}
+
+func resourceKubernetesPodPresetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Reading pod preset %s", name)
+	preset, err := conn.SettingsV1alpha1().PodPresets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received pod preset: %#v", preset)
+
+	err = d.Set("metadata", flattenMetadata(preset.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	spec, err := flattenPodPresetSpec(preset.Spec)
+	if err != nil {
+		return err
+	}
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesPodPresetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating pod preset %q: %v", name, string(data))
+	out, err := conn.SettingsV1alpha1().PodPresets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update pod preset: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated pod preset: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesPodPresetRead(d, meta)
+}
+
+func resourceKubernetesPodPresetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting pod preset: %#v", name)
+	err = conn.SettingsV1alpha1().PodPresets(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Pod preset %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPodPresetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn := meta.(*kubernetesProvider).conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking pod preset %s", name)
+	_, err = conn.SettingsV1alpha1().PodPresets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
@@ -0,0 +1,448 @@
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+const podSecurityPolicyResourceGroupName = "podsecuritypolicies"
+
+var podSecurityPolicyAPIGroups = []APIGroup{policyV1beta1, extensionsV1beta1}
+
+var podSecurityPolicyNotSupportedError = errors.New("could not find Kubernetes API group that supports PodSecurityPolicy resources")
+
+func resourceKubernetesPodSecurityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesPodSecurityPolicyCreate,
+		Read:   resourceKubernetesPodSecurityPolicyRead,
+		Exists: resourceKubernetesPodSecurityPolicyExists,
+		Update: resourceKubernetesPodSecurityPolicyUpdate,
+		Delete: resourceKubernetesPodSecurityPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("pod security policy", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the policy enforced. More info: https://kubernetes.io/docs/concepts/policy/pod-security-policy/",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: podSecurityPolicySpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func podSecurityPolicySpecFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allow_privilege_escalation": {
+			Type:        schema.TypeBool,
+			Description: "Determines if a pod can request to allow privilege escalation. If unspecified, defaults to true.",
+			Optional:    true,
+			Default:     true,
+		},
+		"allowed_capabilities": {
+			Type:        schema.TypeList,
+			Description: "List of capabilities that can be requested to add to the container. Capabilities in this field may be added at the pod author's discretion. You must not list a capability in both allowed_capabilities and required_drop_capabilities.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"allowed_flex_volumes": {
+			Type:        schema.TypeList,
+			Description: "Whitelist of allowed Flexvolumes. Empty or nil indicates that all Flexvolumes may be used. This parameter is effective only when the usage of the Flexvolumes is allowed in the volumes field.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"driver": {
+						Type:        schema.TypeString,
+						Description: "Name of the Flexvolume driver.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"allowed_host_paths": {
+			Type:        schema.TypeList,
+			Description: "Whitelist of host paths. Empty indicates that all host paths may be used.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"path_prefix": {
+						Type:        schema.TypeString,
+						Description: "The path prefix that the host volume must match. It does not support `*`. Trailing slashes are trimmed when validating the path prefix with a host path.",
+						Required:    true,
+					},
+					"read_only": {
+						Type:        schema.TypeBool,
+						Description: "When set to true, will allow host volumes matching the path_prefix only if all volume mounts are read only.",
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"allowed_unsafe_sysctls": {
+			Type:        schema.TypeList,
+			Description: "List of explicitly allowed unsafe sysctls, defaults to none. Each entry is either a plain sysctl name or ends in `*` in which case it is considered as a prefix of allowed sysctls. Single `*` means all unsafe sysctls are allowed.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"default_add_capabilities": {
+			Type:        schema.TypeList,
+			Description: "The default set of capabilities that will be added to the container unless the pod spec specifically drops the capability. You may not list a capability in both default_add_capabilities and required_drop_capabilities.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"default_allow_privilege_escalation": {
+			Type:        schema.TypeBool,
+			Description: "Controls the default setting for whether a process can gain more privileges than its parent process.",
+			Optional:    true,
+			Computed:    true,
+		},
+		"forbidden_sysctls": {
+			Type:        schema.TypeList,
+			Description: "List of explicitly forbidden sysctls, defaults to none. Each entry is either a plain sysctl name or ends in `*` in which case it is considered as a prefix of forbidden sysctls. Single `*` means all sysctls are forbidden.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"fs_group": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate what fs group is used by the security context.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podSecurityPolicyIDRangeStrategyFields([]string{"MustRunAs", "RunAsAny"}),
+			},
+		},
+		"host_ipc": {
+			Type:        schema.TypeBool,
+			Description: "Determines if the policy allows the use of host_ipc in the pod spec.",
+			Optional:    true,
+			Default:     false,
+		},
+		"host_network": {
+			Type:        schema.TypeBool,
+			Description: "Determines if the policy allows the use of host_network in the pod spec.",
+			Optional:    true,
+			Default:     false,
+		},
+		"host_pid": {
+			Type:        schema.TypeBool,
+			Description: "Determines if the policy allows the use of host_pid in the pod spec.",
+			Optional:    true,
+			Default:     false,
+		},
+		"host_ports": {
+			Type:        schema.TypeList,
+			Description: "Determines which host port ranges are allowed to be exposed.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"min": {
+						Type:        schema.TypeInt,
+						Description: "Start of the range, inclusive.",
+						Required:    true,
+					},
+					"max": {
+						Type:        schema.TypeInt,
+						Description: "End of the range, inclusive.",
+						Required:    true,
+					},
+				},
+			},
+		},
+		"privileged": {
+			Type:        schema.TypeBool,
+			Description: "Determines if a pod can request to be run as privileged.",
+			Optional:    true,
+			Default:     false,
+		},
+		"read_only_root_filesystem": {
+			Type:        schema.TypeBool,
+			Description: "When set to true will force containers to run with a read only root file system. If the container specifically requests to run with a non-read only root file system the policy should deny the pod. If set to false the container may run with a read only root file system if it wishes but it will not be forced to.",
+			Optional:    true,
+			Default:     false,
+		},
+		"required_drop_capabilities": {
+			Type:        schema.TypeList,
+			Description: "The capabilities that will be dropped from the container. These are required to be dropped and cannot be added.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"run_as_user": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate the allowable run_as_user values that may be set.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podSecurityPolicyIDRangeStrategyFields([]string{"MustRunAs", "MustRunAsNonRoot", "RunAsAny"}),
+			},
+		},
+		"se_linux": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate the allowable labels that may be set.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule": {
+						Type:         schema.TypeString,
+						Description:  "The strategy that will dictate the allowable labels that may be set. One of MustRunAs or RunAsAny.",
+						Required:     true,
+						ValidateFunc: validateAttributeValueIsIn([]string{"MustRunAs", "RunAsAny"}),
+					},
+					"se_linux_options": {
+						Type:        schema.TypeList,
+						Description: "Required to run as; required for MustRunAs.",
+						Optional:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: seLinuxOptionsField(),
+						},
+					},
+				},
+			},
+		},
+		"supplemental_groups": {
+			Type:        schema.TypeList,
+			Description: "The strategy that will dictate what supplemental groups are used by the security context.",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: podSecurityPolicyIDRangeStrategyFields([]string{"MustRunAs", "RunAsAny"}),
+			},
+		},
+		"volumes": {
+			Type:        schema.TypeList,
+			Description: "Whitelist of allowed volume plugins. Empty indicates that no volumes may be used. To allow all volumes you may use `*`.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func podSecurityPolicyIDRangeStrategyFields(rules []string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"rule": {
+			Type:         schema.TypeString,
+			Description:  fmt.Sprintf("The strategy that will dictate which IDs are allowed. One of %s.", strings.Join(rules, ", ")),
+			Required:     true,
+			ValidateFunc: validateAttributeValueIsIn(rules),
+		},
+		"range": {
+			Type:        schema.TypeList,
+			Description: "The allowed ranges of IDs. Required for MustRunAs.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"min": {
+						Type:        schema.TypeInt,
+						Description: "Start of the range, inclusive.",
+						Required:    true,
+					},
+					"max": {
+						Type:        schema.TypeInt,
+						Description: "End of the range, inclusive.",
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesPodSecurityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	psp := policyv1beta1.PodSecurityPolicy{
+		ObjectMeta: metadata,
+		Spec:       expandPodSecurityPolicySpec(d),
+	}
+
+	out := &policyv1beta1.PodSecurityPolicy{}
+	log.Printf("[INFO] Creating new pod security policy: %#v", psp)
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPolicyResourceGroupName, podSecurityPolicyAPIGroups...)
+	if err != nil {
+		return err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		out, err = conn.PolicyV1beta1().PodSecurityPolicies().Create(&psp)
+
+	case extensionsV1beta1:
+		beta := &extensionsv1beta1.PodSecurityPolicy{}
+		err = Convert(&psp, beta)
+		if err != nil {
+			break
+		}
+
+		betaOut, err2 := conn.ExtensionsV1beta1().PodSecurityPolicies().Create(beta)
+		if err2 != nil {
+			err = err2
+			break
+		}
+
+		err = Convert(betaOut, out)
+
+	default:
+		err = podSecurityPolicyNotSupportedError
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to create pod security policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted new pod security policy: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesPodSecurityPolicyRead(d, meta)
+}
+
+func resourceKubernetesPodSecurityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	psp, err := readPodSecurityPolicy(kp, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received pod security policy: %#v", psp)
+	err = d.Set("metadata", flattenMetadata(psp.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", flattenPodSecurityPolicySpec(psp.Spec))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func readPodSecurityPolicy(kp *kubernetesProvider, name string) (psp *policyv1beta1.PodSecurityPolicy, err error) {
+	conn := kp.conn
+
+	log.Printf("[INFO] Reading pod security policy %s", name)
+	psp = &policyv1beta1.PodSecurityPolicy{}
+
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPolicyResourceGroupName, podSecurityPolicyAPIGroups...)
+	if err != nil {
+		return nil, err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		return conn.PolicyV1beta1().PodSecurityPolicies().Get(name, metav1.GetOptions{})
+
+	case extensionsV1beta1:
+		out, err := conn.ExtensionsV1beta1().PodSecurityPolicies().Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		err = Convert(out, psp)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, podSecurityPolicyNotSupportedError
+	}
+
+	return psp, nil
+}
+
+func resourceKubernetesPodSecurityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandPodSecurityPolicySpec(d),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating pod security policy %q: %v", name, string(data))
+
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPolicyResourceGroupName, podSecurityPolicyAPIGroups...)
+	if err != nil {
+		return err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		_, err = conn.PolicyV1beta1().PodSecurityPolicies().Patch(name, pkgApi.JSONPatchType, data)
+	case extensionsV1beta1:
+		_, err = conn.ExtensionsV1beta1().PodSecurityPolicies().Patch(name, pkgApi.JSONPatchType, data)
+	default:
+		err = podSecurityPolicyNotSupportedError
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to update pod security policy: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated pod security policy: %s", name)
+
+	return resourceKubernetesPodSecurityPolicyRead(d, meta)
+}
+
+func resourceKubernetesPodSecurityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting pod security policy: %#v", name)
+
+	apiGroup, err := kp.highestSupportedAPIGroup(podSecurityPolicyResourceGroupName, podSecurityPolicyAPIGroups...)
+	if err != nil {
+		return err
+	}
+	switch apiGroup {
+	case policyV1beta1:
+		err = conn.PolicyV1beta1().PodSecurityPolicies().Delete(name, &metav1.DeleteOptions{})
+	case extensionsV1beta1:
+		err = conn.ExtensionsV1beta1().PodSecurityPolicies().Delete(name, &metav1.DeleteOptions{})
+	default:
+		err = podSecurityPolicyNotSupportedError
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Pod security policy %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesPodSecurityPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	kp := meta.(*kubernetesProvider)
+
+	name := d.Id()
+	log.Printf("[INFO] Checking pod security policy %s", name)
+	_, err := readPodSecurityPolicy(kp, name)
+	if err != nil {
+		if statusErr, ok := err.(*kerrors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
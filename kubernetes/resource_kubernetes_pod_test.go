@@ -3,6 +3,8 @@ package kubernetes
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -378,10 +380,10 @@ func TestAccKubernetesPod_with_resource_requirements(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
 					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.image", imageName),
-					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.requests.0.memory", "50Mi"),
-					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.requests.0.cpu", "250m"),
-					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.limits.0.memory", "512Mi"),
-					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.limits.0.cpu", "500m"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.requests.memory", "50Mi"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.requests.cpu", "250m"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.limits.memory", "512Mi"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "spec.0.container.0.resources.0.limits.cpu", "500m"),
 				),
 			},
 		},
@@ -540,6 +542,76 @@ func TestAccKubernetesPod_with_DNS_config(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_waitForStateReady(t *testing.T) {
+	var conf api.Pod
+
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := "nginx:1.7.9"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWaitForReady(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "wait_for_state", "Ready"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.phase", "Running"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.qos_class", "BestEffort"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod.test", "status.0.pod_ip"),
+					resource.TestCheckResourceAttrSet("kubernetes_pod.test", "status.0.host_ip"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.name", "containername"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.ready", "true"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.state", "running"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_waitForStateSucceeded(t *testing.T) {
+	var conf api.Pod
+
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigRunToCompletion(podName, "exit 0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.phase", "Succeeded"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.state", "terminated"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", "status.0.container_status.0.reason", "Completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_waitForStateFailsFast(t *testing.T) {
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPodConfigRunToCompletion(podName, "exit 3"),
+				ExpectError: regexp.MustCompile("failed(.|\\n)*exit code 3"),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesPodDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*kubernetesProvider).conn
 
@@ -1185,3 +1257,122 @@ resource "kubernetes_pod" "test" {
 }
 `, podName, imageName)
 }
+
+func testAccKubernetesPodConfigWaitForReady(podName, imageName string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image = "%s"
+      name  = "containername"
+
+      readiness_probe {
+        http_get {
+          path = "/"
+          port = 80
+        }
+
+        initial_delay_seconds = 3
+      }
+    }
+  }
+
+  wait_for_state = "Ready"
+}
+`, podName, imageName)
+}
+
+func testAccKubernetesPodConfigRunToCompletion(podName, script string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image   = "alpine"
+      name    = "containername"
+      command = ["sh", "-c", "%s"]
+    }
+
+    restart_policy = "Never"
+  }
+
+  wait_for_state = "Succeeded"
+}
+`, podName, script)
+}
+
+func TestPodReachedState(t *testing.T) {
+	ready := func(status api.ConditionStatus) []api.PodCondition {
+		return []api.PodCondition{{Type: api.PodReady, Status: status}}
+	}
+	cases := []struct {
+		Status        api.PodStatus
+		Target        string
+		Expected      bool
+		ExpectedError string
+	}{
+		{api.PodStatus{Phase: api.PodPending}, podWaitRunning, false, ""},
+		{api.PodStatus{Phase: api.PodRunning}, podWaitRunning, true, ""},
+		{api.PodStatus{Phase: api.PodSucceeded}, podWaitRunning, true, ""},
+		{api.PodStatus{Phase: api.PodRunning, Conditions: ready(api.ConditionFalse)}, podWaitReady, false, ""},
+		{api.PodStatus{Phase: api.PodRunning, Conditions: ready(api.ConditionTrue)}, podWaitReady, true, ""},
+		{api.PodStatus{Phase: api.PodSucceeded}, podWaitReady, false, "completed before becoming ready"},
+		{api.PodStatus{Phase: api.PodRunning}, podWaitSucceeded, false, ""},
+		{api.PodStatus{Phase: api.PodSucceeded}, podWaitSucceeded, true, ""},
+		{
+			api.PodStatus{
+				Phase: api.PodFailed,
+				ContainerStatuses: []api.ContainerStatus{
+					{
+						Name: "migrate",
+						State: api.ContainerState{Terminated: &api.ContainerStateTerminated{
+							ExitCode: 2, Reason: "Error", Message: "no such table",
+						}},
+					},
+				},
+			},
+			podWaitSucceeded, false, "failed\n   * migrate (Container): Error: exit code 2: no such table",
+		},
+		{
+			api.PodStatus{
+				Phase: api.PodRunning,
+				ContainerStatuses: []api.ContainerStatus{
+					{
+						Name: "web",
+						State: api.ContainerState{Waiting: &api.ContainerStateWaiting{
+							Reason: "CrashLoopBackOff",
+						}},
+						LastTerminationState: api.ContainerState{Terminated: &api.ContainerStateTerminated{
+							ExitCode: 137, Reason: "OOMKilled",
+						}},
+					},
+				},
+			},
+			podWaitReady, false, "crash looping\n   * web (Container): OOMKilled: exit code 137",
+		},
+	}
+
+	for i, tc := range cases {
+		pod := &api.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Status: tc.Status}
+		done, err := podReachedState(pod, tc.Target)
+		if tc.ExpectedError != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Fatalf("case %d: expected error containing %q, got %v", i, tc.ExpectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %s", i, err)
+		}
+		if done != tc.Expected {
+			t.Fatalf("case %d: expected %t, got %t", i, tc.Expected, done)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	appsv1 "k8s.io/api/apps/v1"
+	appsv1beta2 "k8s.io/api/apps/v1beta2"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+const replicaSetResourceGroupName = "replicasets"
+
+var replicaSetAPIGroups = []APIGroup{appsV1, appsV1beta2, extensionsV1beta1}
+
+var replicaSetNotSupportedError = errors.New("could not find Kubernetes API group that supports ReplicaSet resources")
+
+func resourceKubernetesReplicaSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKubernetesReplicaSetCreate,
+		Read:   resourceKubernetesReplicaSetRead,
+		Exists: resourceKubernetesReplicaSetExists,
+		Update: resourceKubernetesReplicaSetUpdate,
+		Delete: resourceKubernetesReplicaSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: podSpecBlockVolumesCustomizeDiff("spec.0.template.0.spec", ""),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("replica set", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the specification of the desired behavior of the replica set. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#spec-and-status",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_ready_seconds": {
+							Type:        schema.TypeInt,
+							Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)",
+							Optional:    true,
+							Default:     0,
+						},
+						"replicas": {
+							Type:        schema.TypeInt,
+							Description: "The number of desired replicas. Defaults to 1. More info: https://kubernetes.io/docs/concepts/workloads/controllers/replicationcontroller#what-is-a-replicationcontroller",
+							Optional:    true,
+							Default:     1,
+						},
+						"selector": {
+							Type:        schema.TypeList,
+							Description: "A label query over pods that should match the replica count. Label keys and values that must match in order to be controlled by this replica set. It must match the pod template's labels. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: labelSelectorFields(false),
+							},
+						},
+						"template": {
+							Type:        schema.TypeList,
+							Description: "Template describes the pods that will be created in case of insufficient replicas.",
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metadata": metadataSchema("replicaSetSpec", true),
+									"spec": {
+										Type:        schema.TypeList,
+										Description: "Spec describes the pods that will be created.",
+										Required:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: podSpecFields(true),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesReplicaSetCreate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandReplicaSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if metadata.Namespace == "" {
+		metadata.Namespace = "default"
+	}
+
+	rs := appsv1.ReplicaSet{
+		ObjectMeta: metadata,
+		Spec:       spec,
+	}
+
+	out := &appsv1.ReplicaSet{}
+
+	log.Printf("[INFO] Creating new replica set: %#v", rs)
+	apiGroup, err := kp.highestSupportedAPIGroup(replicaSetResourceGroupName, replicaSetAPIGroups...)
+	if err != nil {
+		return err
+	}
+	switch apiGroup {
+	case appsV1:
+		out, err = conn.AppsV1().ReplicaSets(metadata.Namespace).Create(&rs)
+
+	case appsV1beta2:
+		beta := &appsv1beta2.ReplicaSet{}
+		err = Convert(&rs, beta)
+		if err != nil {
+			break
+		}
+
+		beta, err = conn.AppsV1beta2().ReplicaSets(metadata.Namespace).Create(beta)
+		if err != nil {
+			break
+		}
+
+		err = Convert(beta, out)
+
+	case extensionsV1beta1:
+		beta := &extensionsv1beta1.ReplicaSet{}
+		err = Convert(&rs, beta)
+		if err != nil {
+			break
+		}
+
+		beta, err = conn.ExtensionsV1beta1().ReplicaSets(metadata.Namespace).Create(beta)
+		if err != nil {
+			break
+		}
+
+		err = Convert(beta, out)
+
+	default:
+		err = replicaSetNotSupportedError
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to create replica set: %s", err)
+	}
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	log.Printf("[DEBUG] Waiting for replica set %s to schedule %d replicas",
+		d.Id(), *out.Spec.Replicas)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate),
+		waitForReplicaSetReplicasFunc(kp, out.GetNamespace(), out.GetName()))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Submitted new replica set: %#v", out)
+
+	return resourceKubernetesReplicaSetRead(d, meta)
+}
+
+func resourceKubernetesReplicaSetRead(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rs, err := readReplicaSet(kp, namespace, name)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return err
+	}
+	log.Printf("[INFO] Received replica set: %#v", rs)
+
+	rs.ObjectMeta.Labels = reconcileTopLevelLabels(
+		rs.ObjectMeta.Labels,
+		expandMetadata(d.Get("metadata").([]interface{})),
+		expandMetadata(d.Get("spec.0.template.0.metadata").([]interface{})),
+	)
+	err = d.Set("metadata", flattenMetadata(rs.ObjectMeta, d))
+	if err != nil {
+		return err
+	}
+
+	spec, err := flattenReplicaSetSpec(rs.Spec, d)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("spec", spec)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKubernetesReplicaSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+	if d.HasChange("spec") {
+		spec, err := expandReplicaSetSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating replica set %q: %v", name, string(data))
+
+	out, err := patchReplicaSet(kp, namespace, name, data)
+	if err != nil {
+		return fmt.Errorf("Failed to update replica set: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated replica set: %#v", out)
+
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate),
+		waitForReplicaSetReplicasFunc(kp, namespace, name))
+	if err != nil {
+		return err
+	}
+
+	return resourceKubernetesReplicaSetRead(d, meta)
+}
+
+func resourceKubernetesReplicaSetDelete(d *schema.ResourceData, meta interface{}) error {
+	kp := meta.(*kubernetesProvider)
+	conn := kp.conn
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting replica set: %#v", name)
+
+	// Drain all replicas before deleting
+	var ops PatchOperations
+	ops = append(ops, &ReplaceOperation{
+		Path:  "/spec/replicas",
+		Value: 0,
+	})
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	_, err = patchReplicaSet(kp, namespace, name, data)
+	if err != nil {
+		return err
+	}
+
+	// Wait until all replicas are gone
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete),
+		waitForReplicaSetReplicasFunc(kp, namespace, name))
+	if err != nil {
+		return err
+	}
+
+	apiGroup, err := kp.highestSupportedAPIGroup(replicaSetResourceGroupName, replicaSetAPIGroups...)
+	if err != nil {
+		return err
+	}
+	switch apiGroup {
+	case appsV1:
+		err = conn.AppsV1().ReplicaSets(namespace).Delete(name, &metav1.DeleteOptions{})
+	case appsV1beta2:
+		err = conn.AppsV1beta2().ReplicaSets(namespace).Delete(name, &metav1.DeleteOptions{})
+	case extensionsV1beta1:
+		err = conn.ExtensionsV1beta1().ReplicaSets(namespace).Delete(name, &metav1.DeleteOptions{})
+	default:
+		err = replicaSetNotSupportedError
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Replica set %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesReplicaSetExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	kp := meta.(*kubernetesProvider)
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking replica set %s", name)
+	_, err = readReplicaSet(kp, namespace, name)
+	if err != nil {
+		if statusErr, ok := err.(*kerrors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+func patchReplicaSet(kp *kubernetesProvider, namespace, name string, data []byte) (*appsv1.ReplicaSet, error) {
+	conn := kp.conn
+	rs := &appsv1.ReplicaSet{}
+
+	apiGroup, err := kp.highestSupportedAPIGroup(replicaSetResourceGroupName, replicaSetAPIGroups...)
+	if err != nil {
+		return nil, err
+	}
+	switch apiGroup {
+	case appsV1:
+		return conn.AppsV1().ReplicaSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+
+	case appsV1beta2:
+		beta, err := conn.AppsV1beta2().ReplicaSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		if err != nil {
+			return nil, err
+		}
+		err = Convert(beta, rs)
+		return rs, err
+
+	case extensionsV1beta1:
+		beta, err := conn.ExtensionsV1beta1().ReplicaSets(namespace).Patch(name, pkgApi.JSONPatchType, data)
+		if err != nil {
+			return nil, err
+		}
+		err = Convert(beta, rs)
+		return rs, err
+
+	default:
+		return nil, replicaSetNotSupportedError
+	}
+}
+
+func readReplicaSet(kp *kubernetesProvider, namespace, name string) (*appsv1.ReplicaSet, error) {
+	conn := kp.conn
+	rs := &appsv1.ReplicaSet{}
+
+	log.Printf("[INFO] Reading replica set %s", name)
+	apiGroup, err := kp.highestSupportedAPIGroup(replicaSetResourceGroupName, replicaSetAPIGroups...)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] Reading replica set using %s API Group", apiGroup)
+
+	switch apiGroup {
+	case appsV1:
+		return conn.AppsV1().ReplicaSets(namespace).Get(name, metav1.GetOptions{})
+
+	case appsV1beta2:
+		beta, err := conn.AppsV1beta2().ReplicaSets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		err = Convert(beta, rs)
+		return rs, err
+
+	case extensionsV1beta1:
+		beta, err := conn.ExtensionsV1beta1().ReplicaSets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		err = Convert(beta, rs)
+		return rs, err
+
+	default:
+		return nil, replicaSetNotSupportedError
+	}
+}
+
+func waitForReplicaSetReplicasFunc(kp *kubernetesProvider, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		rs, err := readReplicaSet(kp, ns, name)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		desiredReplicas := *rs.Spec.Replicas
+		log.Printf("[DEBUG] Current number of labelled replicas of %q: %d (of %d)\n",
+			rs.GetName(), rs.Status.Replicas, desiredReplicas)
+
+		if rs.Status.Replicas == desiredReplicas {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Waiting for %d replicas of %q to be scheduled (%d)",
+			desiredReplicas, rs.GetName(), rs.Status.Replicas))
+	}
+}
@@ -0,0 +1,175 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func TestAccKubernetesReplicaSet_basic(t *testing.T) {
+	var conf appsv1.ReplicaSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_replica_set.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesReplicaSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesReplicaSetConfig_basic(name, "nginx:1.7.8", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicaSetExists("kubernetes_replica_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "metadata.0.annotations.TestAnnotationOne", "one"),
+					testAccCheckMetaAnnotations(&conf.ObjectMeta, map[string]string{"TestAnnotationOne": "one"}),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet("kubernetes_replica_set.test", "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet("kubernetes_replica_set.test", "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet("kubernetes_replica_set.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttrSet("kubernetes_replica_set.test", "metadata.0.uid"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.selector.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.selector.0.match_labels.%", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.selector.0.match_labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.selector.0.match_expressions.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.selector.0.match_expressions.0.key", "tier"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.selector.0.match_expressions.0.operator", "In"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.selector.0.match_expressions.0.values.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.7.8"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.template.0.spec.0.container.0.name", "tf-acc-test"),
+				),
+			},
+			{
+				Config: testAccKubernetesReplicaSetConfig_basic(name, "nginx:1.7.9", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicaSetExists("kubernetes_replica_set.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.replicas", "3"),
+					resource.TestCheckResourceAttr("kubernetes_replica_set.test", "spec.0.template.0.spec.0.container.0.image", "nginx:1.7.9"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesReplicaSet_importBasic(t *testing.T) {
+	resourceName := "kubernetes_replica_set.test"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKubernetesReplicaSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesReplicaSetConfig_basic(name, "nginx:1.7.8", 2),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesReplicaSetDestroy(s *terraform.State) error {
+	kp := testAccProvider.Meta().(*kubernetesProvider)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_replica_set" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := readReplicaSet(kp, namespace, name)
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Replica set still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesReplicaSetExists(n string, obj *appsv1.ReplicaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		kp := testAccProvider.Meta().(*kubernetesProvider)
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := readReplicaSet(kp, namespace, name)
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesReplicaSetConfig_basic(name, image string, replicas int) string {
+	return fmt.Sprintf(`
+resource "kubernetes_replica_set" "test" {
+  metadata {
+    annotations {
+      TestAnnotationOne = "one"
+    }
+    labels {
+      TestLabelOne = "one"
+    }
+    name = "%s"
+  }
+
+  spec {
+    replicas = %d
+
+    selector {
+      match_labels {
+        TestLabelOne = "one"
+      }
+      match_expressions {
+        key      = "tier"
+        operator = "In"
+        values   = ["frontend", "backend"]
+      }
+    }
+
+    template {
+      metadata {
+        labels {
+          TestLabelOne = "one"
+          tier         = "frontend"
+        }
+      }
+
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}
+`, name, replicas, image)
+}
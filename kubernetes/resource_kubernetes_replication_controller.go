@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +25,9 @@ func resourceKubernetesReplicationController() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		SchemaVersion: 1,
+		MigrateState:  resourceKubernetesReplicationControllerStateUpgrader,
+		CustomizeDiff: podSpecBlockVolumesCustomizeDiff("spec.0.template", ""),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -69,6 +73,7 @@ func resourceKubernetesReplicationController() *schema.Resource {
 					},
 				},
 			},
+			"status": replicationControllerStatusSchema(),
 		},
 	}
 }
@@ -144,6 +149,11 @@ func resourceKubernetesReplicationControllerRead(d *schema.ResourceData, meta in
 		return err
 	}
 
+	err = d.Set("status", flattenReplicationControllerStatus(rc.Status))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -269,3 +279,24 @@ func waitForDesiredReplicasFunc(conn *kubernetes.Clientset, ns, name string) res
 			desiredReplicas, rc.GetName(), rc.Status.FullyLabeledReplicas))
 	}
 }
+
+func resourceKubernetesReplicationControllerStateUpgrader(
+	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	var err error
+
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Kubernetes ReplicationController State v0; migrating to v1")
+		is, err = migrateContainerResourcesState(is)
+
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+
+	return is, err
+}
@@ -48,6 +48,73 @@ func resourceKubernetesResourceQuota() *schema.Resource {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Set:         schema.HashString,
 						},
+						"scope_selector": {
+							Type:        schema.TypeList,
+							Description: "A collection of filters like `scopes` that must match each object tracked by a quota but expressed using scope selector operators. Requires Kubernetes 1.11 or later.",
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_expression": {
+										Type:        schema.TypeList,
+										Description: "A list of scope selector requirements by scope of the resources.",
+										Required:    true,
+										ForceNew:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"scope_name": {
+													Type:         schema.TypeString,
+													Description:  "The name of the scope that the selector applies to.",
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateAttributeValueIsIn([]string{"Terminating", "NotTerminating", "BestEffort", "NotBestEffort", "PriorityClass"}),
+												},
+												"operator": {
+													Type:         schema.TypeString,
+													Description:  "Represents a scope's relationship to a set of values. Valid operators are `In`, `NotIn`, `Exists` and `DoesNotExist`.",
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validateAttributeValueIsIn([]string{"In", "NotIn", "Exists", "DoesNotExist"}),
+												},
+												"values": {
+													Type:        schema.TypeSet,
+													Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty.",
+													Optional:    true,
+													ForceNew:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+													Set:         schema.HashString,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "The actual enforced quota and its current usage.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hard": {
+							Type:        schema.TypeMap,
+							Description: "The enforced hard limits for each named resource.",
+							Computed:    true,
+						},
+						"used": {
+							Type:        schema.TypeMap,
+							Description: "The current observed total usage of the resource in the namespace.",
+							Computed:    true,
+						},
+						"remaining": {
+							Type:        schema.TypeMap,
+							Description: "How much of each hard limit is left given the current usage. Negative for resources used beyond their limit.",
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -126,6 +193,10 @@ func resourceKubernetesResourceQuotaRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return err
 	}
+	err = d.Set("status", flattenResourceQuotaStatus(resQuota.Status))
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
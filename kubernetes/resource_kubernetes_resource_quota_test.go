@@ -42,6 +42,10 @@ func TestAccKubernetesResourceQuota_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.limits.cpu", "2"),
 					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.limits.memory", "2Gi"),
 					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.hard.pods", "4"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "status.0.hard.%", "3"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "status.0.hard.pods", "4"),
+					resource.TestCheckResourceAttrSet("kubernetes_resource_quota.test", "status.0.used.pods"),
+					resource.TestCheckResourceAttrSet("kubernetes_resource_quota.test", "status.0.remaining.pods"),
 				),
 			},
 			{
@@ -176,6 +180,32 @@ func TestAccKubernetesResourceQuota_withScopes(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesResourceQuota_withScopeSelector(t *testing.T) {
+	var conf api.ResourceQuota
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_resource_quota.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesResourceQuotaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesResourceQuotaConfig_withScopeSelector(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesResourceQuotaExists("kubernetes_resource_quota.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expression.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expression.0.scope_name", "PriorityClass"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expression.0.operator", "In"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "spec.0.scope_selector.0.match_expression.0.values.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_resource_quota.test", "status.0.hard.pods", "10"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesResourceQuota_importBasic(t *testing.T) {
 	resourceName := "kubernetes_resource_quota.test"
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
@@ -346,6 +376,28 @@ resource "kubernetes_resource_quota" "test" {
 `, name)
 }
 
+func testAccKubernetesResourceQuotaConfig_withScopeSelector(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_resource_quota" "test" {
+	metadata {
+		name = "%s"
+	}
+	spec {
+		hard {
+			pods = 10
+		}
+		scope_selector {
+			match_expression {
+				scope_name = "PriorityClass"
+				operator = "In"
+				values = ["high", "medium"]
+			}
+		}
+	}
+}
+`, name)
+}
+
 func testAccKubernetesResourceQuotaConfig_withScopesModified(name string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_resource_quota" "test" {
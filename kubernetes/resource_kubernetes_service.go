@@ -20,10 +20,18 @@ func resourceKubernetesService() *schema.Resource {
 		Update: resourceKubernetesServiceUpdate,
 		Delete: resourceKubernetesServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_load_balancer", true)
+				d.Set("wait_for_endpoints", 0)
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 		CustomizeDiff: resourceKubernetesServiceCustomizeDiff,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("service", true),
 			"spec": {
@@ -167,6 +175,19 @@ func resourceKubernetesService() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Description: "Whether to wait for a `LoadBalancer` service to be assigned an IP or hostname on creation. Defaults to `true`.",
+				Optional:    true,
+				Default:     true,
+			},
+			"wait_for_endpoints": {
+				Type:         schema.TypeInt,
+				Description:  "Number of ready endpoint addresses to wait for on creation, so that the service is only considered created once it has backends. Defaults to `0`, which does not wait.",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validateNonNegativeInteger,
+			},
 			"load_balancer_ingress": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -203,10 +224,10 @@ func resourceKubernetesServiceCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] Submitted new service: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	if out.Spec.Type == api.ServiceTypeLoadBalancer {
+	if out.Spec.Type == api.ServiceTypeLoadBalancer && d.Get("wait_for_load_balancer").(bool) {
 		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
 
-		err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			svc, err := conn.CoreV1().Services(out.Namespace).Get(out.Name, meta_v1.GetOptions{})
 			if err != nil {
 				log.Printf("[DEBUG] Received error: %#v", err)
@@ -232,6 +253,38 @@ func resourceKubernetesServiceCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if n := d.Get("wait_for_endpoints").(int); n > 0 {
+		log.Printf("[DEBUG] Waiting for service %s to have %d ready endpoints", d.Id(), n)
+
+		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			ep, err := conn.CoreV1().Endpoints(out.Namespace).Get(out.Name, meta_v1.GetOptions{})
+			if err != nil {
+				if statusErr, ok := err.(*errors.StatusError); ok && statusErr.ErrStatus.Code == 404 {
+					return resource.RetryableError(fmt.Errorf(
+						"Waiting for endpoints of service %q to be created", d.Id()))
+				}
+				log.Printf("[DEBUG] Received error: %#v", err)
+				return resource.NonRetryableError(err)
+			}
+
+			ready := countReadyEndpointAddresses(ep.Subsets)
+			log.Printf("[DEBUG] Service %s has %d ready endpoints (of %d)", d.Id(), ready, n)
+			if ready >= n {
+				return nil
+			}
+
+			return resource.RetryableError(fmt.Errorf(
+				"Waiting for service %q to have %d ready endpoints (%d)", d.Id(), n, ready))
+		})
+		if err != nil {
+			lastWarnings, wErr := getLastWarningsForObject(conn, out.ObjectMeta, "Service", 3)
+			if wErr != nil {
+				return wErr
+			}
+			return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		}
+	}
+
 	return resourceKubernetesServiceRead(d, meta)
 }
 
@@ -381,6 +381,50 @@ func TestAccKubernetesService_headless(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesService_waitForEndpoints(t *testing.T) {
+	var conf api.Service
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "kubernetes_service.test",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceConfig_waitForEndpoints(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists("kubernetes_service.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "wait_for_endpoints", "1"),
+					resource.TestCheckResourceAttr("kubernetes_service.test", "wait_for_load_balancer", "true"),
+					testAccCheckKubernetesServiceReadyEndpoints("kubernetes_service.test", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestCountReadyEndpointAddresses(t *testing.T) {
+	subsets := []api.EndpointSubset{
+		{
+			Addresses:         []api.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+			NotReadyAddresses: []api.EndpointAddress{{IP: "10.0.0.3"}},
+		},
+		{
+			Addresses: []api.EndpointAddress{{IP: "10.0.0.4"}},
+		},
+		{
+			NotReadyAddresses: []api.EndpointAddress{{IP: "10.0.0.5"}},
+		},
+	}
+	if n := countReadyEndpointAddresses(subsets); n != 3 {
+		t.Fatalf("expected 3 ready addresses, got %d", n)
+	}
+	if n := countReadyEndpointAddresses(nil); n != 0 {
+		t.Fatalf("expected 0 ready addresses, got %d", n)
+	}
+}
+
 func TestAccKubernetesService_sessionAffinityConfig(t *testing.T) {
 	var conf api.Service
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -745,6 +789,67 @@ resource "kubernetes_service" "test" {
 `, name)
 }
 
+func testAccCheckKubernetesServiceReadyEndpoints(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*kubernetesProvider).conn
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		ep, err := conn.CoreV1().Endpoints(namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if ready := countReadyEndpointAddresses(ep.Subsets); ready < expected {
+			return fmt.Errorf("Expected at least %d ready endpoints, got %d", expected, ready)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesServiceConfig_waitForEndpoints(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+    labels {
+      App = "%s"
+    }
+  }
+  spec {
+    container {
+      image = "nginx:1.7.9"
+      name  = "tf-acc-test"
+    }
+  }
+}
+
+resource "kubernetes_service" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    port {
+      port        = 80
+      target_port = 80
+    }
+    selector {
+      App = "${kubernetes_pod.test.metadata.0.labels.App}"
+    }
+  }
+  wait_for_endpoints = 1
+}
+`, name, name, name)
+}
+
 func testAccKubernetesServiceConfig_externalTrafficPolicy(name string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_service" "test" {
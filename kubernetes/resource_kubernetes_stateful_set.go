@@ -31,8 +31,12 @@ func resourceKubernetesStatefulSet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		SchemaVersion: 1,
+		SchemaVersion: 3,
 		MigrateState:  resourceKubernetesStatefulSetStateUpgrader,
+		CustomizeDiff: sequenceCustomizeDiff(
+			templateSelectorCustomizeDiff("spec.0.selector", "spec.0.template.0.metadata.0.labels"),
+			podSpecBlockVolumesCustomizeDiff("spec.0.template.0.spec", "spec.0.volume_claim_templates"),
+		),
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("statefulset", true),
 			"spec": {
@@ -63,10 +67,14 @@ func resourceKubernetesStatefulSet() *schema.Resource {
 							ForceNew:    true,
 						},
 						"selector": {
-							Type:        schema.TypeMap,
+							Type:        schema.TypeList,
 							Description: "A label query over pods that should match the Replicas count. More info: http://kubernetes.io/docs/user-guide/labels#label-selectors",
 							Required:    true,
 							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: labelSelectorFields(false),
+							},
 						},
 						"service_name": {
 							Type:        schema.TypeString,
@@ -155,6 +163,7 @@ func resourceKubernetesStatefulSet() *schema.Resource {
 					},
 				},
 			},
+			"status": statefulSetStatusSchema(),
 		},
 	}
 }
@@ -261,6 +270,11 @@ func resourceKubernetesStatefulSetRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	err = d.Set("status", flattenStatefulSetStatus(statefulSet.Status))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -491,6 +505,17 @@ func resourceKubernetesStatefulSetStateUpgrader(
 		if err != nil {
 			return is, err
 		}
+		fallthrough
+	case 1:
+		log.Println("[INFO] Found Kubernetes StatefulSet State schema v1; migrating to v2")
+		is, err = migrateContainerResourcesState(is)
+		if err != nil {
+			return is, err
+		}
+		fallthrough
+	case 2:
+		log.Println("[INFO] Found Kubernetes StatefulSet State schema v2; migrating to v3")
+		is, err = migrateSelectorMapState(is, "spec.0.selector")
 
 	default:
 		return is, fmt.Errorf("Unexpected schema version: %d", v)
@@ -33,6 +33,7 @@ func TestAccKubernetesStatefulSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.generation"),
 					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.resource_version"),
 					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.self_link"),
+					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "status.#", "1"),
 					resource.TestCheckResourceAttrSet("kubernetes_stateful_set.test", "metadata.0.uid"),
 					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.service_name", statefulSetName),
 					resource.TestCheckResourceAttr("kubernetes_stateful_set.test", "spec.0.pod_management_policy", "Parallel"),
@@ -184,7 +185,9 @@ resource "kubernetes_stateful_set" "test" {
   spec {
     replicas = 2
     selector {
-      app = "one"
+      match_labels {
+        app = "one"
+      }
     }
 	pod_management_policy = "%s"
     service_name = "%s"
@@ -215,7 +218,9 @@ resource "kubernetes_stateful_set" "test" {
   spec {
     replicas = 2
     selector {
-      app = "one"
+      match_labels {
+        app = "one"
+      }
     }
     service_name = "%s"
     template {
@@ -262,7 +267,9 @@ resource kubernetes_stateful_set "test" {
 
   spec {
     selector {
-      app = "pinger"
+      match_labels {
+        app = "pinger"
+      }
     }
 
     service_name = "%s"
@@ -304,7 +311,9 @@ resource kubernetes_stateful_set "test" {
 
   spec {
     selector {
-      app = "pinger"
+      match_labels {
+        app = "pinger"
+      }
     }
 
     service_name = "%s"
@@ -44,6 +44,74 @@ func resourceKubernetesStorageClass() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"volume_binding_mode": {
+				Type:         schema.TypeString,
+				Description:  "Indicates when volume binding and dynamic provisioning should occur",
+				Optional:     true,
+				Default:      "Immediate",
+				ForceNew:     true,
+				ValidateFunc: validateAttributeValueIsIn([]string{"Immediate", "WaitForFirstConsumer"}),
+			},
+			"allow_volume_expansion": {
+				Type:        schema.TypeBool,
+				Description: "Indicates whether the storage class allow volume expand",
+				Optional:    true,
+				Default:     false,
+			},
+			"mount_options": {
+				Type:        schema.TypeSet,
+				Description: "Persistent Volumes that are dynamically created by a storage class will have the mount options specified",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"allowed_topologies": {
+				Type:        schema.TypeList,
+				Description: "Restrict the node topologies where volumes can be dynamically provisioned.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"match_label_expressions": {
+							Type:        schema.TypeList,
+							Description: "A list of topology selector requirements by labels.",
+							Optional:    true,
+							ForceNew:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Description: "The label key that the selector applies to.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"values": {
+										Type:        schema.TypeSet,
+										Description: "An array of string values. One value must match the label to be selected.",
+										Required:    true,
+										ForceNew:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"is_default_class": {
+				Type:        schema.TypeBool,
+				Description: "Marks this storage class as the default for claims that do not request a particular class",
+				Optional:    true,
+				Default:     false,
+			},
+			"demote_existing_default": {
+				Type:        schema.TypeBool,
+				Description: "Allows another storage class marked as default to be demoted when `is_default_class` is set",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -66,6 +134,31 @@ func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface
 		storageClass.Parameters = expandStringMap(v.(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("volume_binding_mode"); ok {
+		storageClass.VolumeBindingMode = new(api.VolumeBindingMode)
+		*storageClass.VolumeBindingMode = api.VolumeBindingMode(v.(string))
+	}
+
+	storageClass.AllowVolumeExpansion = ptrToBool(d.Get("allow_volume_expansion").(bool))
+
+	if v, ok := d.GetOk("mount_options"); ok {
+		storageClass.MountOptions = sliceOfString(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("allowed_topologies"); ok {
+		storageClass.AllowedTopologies = expandTopologySelectorTerms(v.([]interface{}))
+	}
+
+	var demoted []api.StorageClass
+	if d.Get("is_default_class").(bool) {
+		var err error
+		demoted, err = defaultStorageClassesToDemote(conn, metadata.Name, d.Get("demote_existing_default").(bool))
+		if err != nil {
+			return err
+		}
+		setDefaultStorageClassAnnotation(&storageClass.ObjectMeta, true)
+	}
+
 	log.Printf("[INFO] Creating new storage class: %#v", storageClass)
 	out, err := conn.StorageV1().StorageClasses().Create(&storageClass)
 	if err != nil {
@@ -74,6 +167,11 @@ func resourceKubernetesStorageClassCreate(d *schema.ResourceData, meta interface
 	log.Printf("[INFO] Submitted new storage class: %#v", out)
 	d.SetId(out.Name)
 
+	err = demoteDefaultStorageClasses(conn, demoted)
+	if err != nil {
+		return err
+	}
+
 	return resourceKubernetesStorageClassRead(d, meta)
 }
 
@@ -95,6 +193,17 @@ func resourceKubernetesStorageClassRead(d *schema.ResourceData, meta interface{}
 	d.Set("reclaim_policy", storageClass.ReclaimPolicy)
 	d.Set("parameters", storageClass.Parameters)
 	d.Set("storage_provisioner", storageClass.Provisioner)
+	if storageClass.VolumeBindingMode != nil {
+		d.Set("volume_binding_mode", string(*storageClass.VolumeBindingMode))
+	}
+	if storageClass.AllowVolumeExpansion != nil {
+		d.Set("allow_volume_expansion", *storageClass.AllowVolumeExpansion)
+	} else {
+		d.Set("allow_volume_expansion", false)
+	}
+	d.Set("mount_options", newStringSet(schema.HashString, storageClass.MountOptions))
+	d.Set("allowed_topologies", flattenTopologySelectorTerms(storageClass.AllowedTopologies))
+	d.Set("is_default_class", isDefaultStorageClass(storageClass.ObjectMeta))
 
 	return nil
 }
@@ -104,6 +213,12 @@ func resourceKubernetesStorageClassUpdate(d *schema.ResourceData, meta interface
 
 	name := d.Id()
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("allow_volume_expansion") {
+		ops = append(ops, &AddOperation{
+			Path:  "/allowVolumeExpansion",
+			Value: d.Get("allow_volume_expansion").(bool),
+		})
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("Failed to marshal update operations: %s", err)
@@ -114,6 +229,27 @@ func resourceKubernetesStorageClassUpdate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Failed to update storage class: %s", err)
 	}
 	log.Printf("[INFO] Submitted updated storage class: %#v", out)
+
+	if d.HasChange("is_default_class") {
+		isDefault := d.Get("is_default_class").(bool)
+		var demoted []api.StorageClass
+		if isDefault {
+			demoted, err = defaultStorageClassesToDemote(conn, name, d.Get("demote_existing_default").(bool))
+			if err != nil {
+				return err
+			}
+		}
+		setDefaultStorageClassAnnotation(&out.ObjectMeta, isDefault)
+		log.Printf("[INFO] Setting default annotation of storage class %q to %t", name, isDefault)
+		out, err = conn.StorageV1().StorageClasses().Update(out)
+		if err != nil {
+			return fmt.Errorf("Failed to update storage class: %s", err)
+		}
+		err = demoteDefaultStorageClasses(conn, demoted)
+		if err != nil {
+			return err
+		}
+	}
 	d.SetId(buildId(out.ObjectMeta))
 
 	return resourceKubernetesStorageClassRead(d, meta)
@@ -70,6 +70,14 @@ func TestAccKubernetesStorageClass_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "parameters.type", "pd-standard"),
 					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "parameters.zones", "us-west1-a,us-west1-b"),
 					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "reclaim_policy", "Delete"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "volume_binding_mode", "WaitForFirstConsumer"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allow_volume_expansion", "true"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "mount_options.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.0.match_label_expressions.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.0.match_label_expressions.0.key", "failure-domain.beta.kubernetes.io/zone"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "allowed_topologies.0.match_label_expressions.0.values.#", "2"),
+					resource.TestCheckResourceAttr("kubernetes_storage_class.test", "is_default_class", "false"),
 					testAccCheckStorageClassParameters(&conf, map[string]string{"type": "pd-standard", "zones": "us-west1-a,us-west1-b"}),
 				),
 			},
@@ -113,7 +121,7 @@ func TestAccKubernetesStorageClass_importBasic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "demote_existing_default"},
 			},
 		},
 	})
@@ -166,7 +174,7 @@ func TestAccKubernetesStorageClass_importGeneratedName(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "demote_existing_default"},
 			},
 		},
 	})
@@ -266,6 +274,15 @@ resource "kubernetes_storage_class" "test" {
 		type = "pd-standard"
 		zones = "us-west1-a,us-west1-b"
 	}
+	volume_binding_mode = "WaitForFirstConsumer"
+	allow_volume_expansion = true
+	mount_options = ["debug", "noatime"]
+	allowed_topologies {
+		match_label_expressions {
+			key = "failure-domain.beta.kubernetes.io/zone"
+			values = ["us-west1-a", "us-west1-b"]
+		}
+	}
 }`, name)
 }
 
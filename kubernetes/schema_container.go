@@ -1,6 +1,13 @@
 package kubernetes
 
-import "github.com/hashicorp/terraform/helper/schema"
+import (
+	"log"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
 
 func handlerFields() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -92,57 +99,63 @@ func handlerFields() map[string]*schema.Schema {
 func resourcesField() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"limits": {
-			Type:        schema.TypeList,
-			Optional:    true,
-			Computed:    true,
-			MaxItems:    1,
-			Description: "Describes the maximum amount of compute resources allowed. More info: http://kubernetes.io/docs/user-guide/compute-resources/",
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"cpu": {
-						Type:             schema.TypeString,
-						Optional:         true,
-						Computed:         true,
-						ValidateFunc:     validateResourceQuantity,
-						DiffSuppressFunc: suppressEquivalentResourceQuantity,
-					},
-					"memory": {
-						Type:             schema.TypeString,
-						Optional:         true,
-						Computed:         true,
-						ValidateFunc:     validateResourceQuantity,
-						DiffSuppressFunc: suppressEquivalentResourceQuantity,
-					},
-				},
-			},
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Describes the maximum amount of compute resources allowed, keyed by resource name (e.g. `cpu`, `memory`, `ephemeral-storage`, `nvidia.com/gpu`). More info: http://kubernetes.io/docs/user-guide/compute-resources/",
+			ValidateFunc:     validateResourceList,
+			DiffSuppressFunc: suppressEquivalentResourceQuantity,
 		},
 		"requests": {
-			Type:     schema.TypeList,
-			Optional: true,
-			Computed: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"cpu": {
-						Type:             schema.TypeString,
-						Optional:         true,
-						Computed:         true,
-						ValidateFunc:     validateResourceQuantity,
-						DiffSuppressFunc: suppressEquivalentResourceQuantity,
-					},
-					"memory": {
-						Type:             schema.TypeString,
-						Optional:         true,
-						Computed:         true,
-						ValidateFunc:     validateResourceQuantity,
-						DiffSuppressFunc: suppressEquivalentResourceQuantity,
-					},
-				},
-			},
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Describes the minimum amount of compute resources required, keyed by resource name (e.g. `cpu`, `memory`, `ephemeral-storage`, `nvidia.com/gpu`). If omitted for a resource it defaults to the limit if that is explicitly specified. More info: http://kubernetes.io/docs/user-guide/compute-resources/",
+			ValidateFunc:     validateResourceList,
+			DiffSuppressFunc: suppressEquivalentResourceQuantity,
 		},
 	}
 }
 
+var containerResourcesStateKeyRegexp = regexp.MustCompile(`^(.+\.resources\.\d+\.(limits|requests))\.(#|0\.(.+))$`)
+
+// Container limits and requests used to be single-item lists with fixed cpu
+// and memory fields. This migration flattens them into the resource quantity
+// maps used now, e.g. resources.0.limits.0.cpu -> resources.0.limits.cpu,
+// dropping empty values left behind by the computed fields.
+func migrateContainerResourcesState(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	newAttributes := make(map[string]string)
+	counts := make(map[string]int)
+
+	for k, v := range is.Attributes {
+		m := containerResourcesStateKeyRegexp.FindStringSubmatch(k)
+		if m == nil {
+			continue
+		}
+		delete(is.Attributes, k)
+		if m[3] == "#" || v == "" {
+			continue
+		}
+
+		newK := m[1] + "." + m[4]
+		newAttributes[newK] = v
+		counts[m[1]]++
+		log.Printf("[DEBUG] moved attribute %s -> %s ", k, newK)
+	}
+
+	for k, v := range newAttributes {
+		is.Attributes[k] = v
+	}
+	for prefix, n := range counts {
+		is.Attributes[prefix+".%"] = strconv.Itoa(n)
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
+
 func seLinuxOptionsField() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"level": {
@@ -175,6 +188,12 @@ func volumeMountFields() map[string]*schema.Schema {
 			Required:    true,
 			Description: "Path within the container at which the volume should be mounted. Must not contain ':'.",
 		},
+		"mount_propagation": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Determines how mounts are propagated from the host to container and the other way around. One of `None`, `HostToContainer` or `Bidirectional`. When not set, `None` is used.",
+			ValidateFunc: validateAttributeValueIsIn([]string{"None", "HostToContainer", "Bidirectional"}),
+		},
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -194,178 +213,116 @@ func volumeMountFields() map[string]*schema.Schema {
 	}
 }
 
-func containerFields(isUpdatable bool) map[string]*schema.Schema {
-	s := map[string]*schema.Schema{
-		"args": {
-			Type:        schema.TypeList,
-			Optional:    true,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/containers#containers-and-commands",
+func volumeDeviceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"device_path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Path inside of the container that the device will be mapped to.",
 		},
-		"command": {
-			Type:        schema.TypeList,
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Must match the name of a persistent volume claim in the pod.",
+		},
+	}
+}
+
+func envFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the environment variable. Must be a C_IDENTIFIER",
+		},
+		"value": {
+			Type:        schema.TypeString,
 			Optional:    true,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/containers#containers-and-commands",
+			Description: `Variable references $(VAR_NAME) are expanded using the previous defined environment variables in the container and any service environment variables. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Defaults to "".`,
 		},
-		"env": {
+		"value_from": {
 			Type:        schema.TypeList,
 			Optional:    true,
-			ForceNew:    !isUpdatable,
-			Description: "List of environment variables to set in the container. Cannot be updated.",
+			MaxItems:    1,
+			Description: "Source for the environment variable's value",
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
-					"name": {
-						Type:        schema.TypeString,
-						Required:    true,
-						Description: "Name of the environment variable. Must be a C_IDENTIFIER",
-					},
-					"value": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: `Variable references $(VAR_NAME) are expanded using the previous defined environment variables in the container and any service environment variables. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Defaults to "".`,
-					},
-					"value_from": {
+					"config_map_key_ref": {
 						Type:        schema.TypeList,
 						Optional:    true,
 						MaxItems:    1,
-						Description: "Source for the environment variable's value",
+						Description: "Selects a key of a ConfigMap.",
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
-								"config_map_key_ref": {
-									Type:        schema.TypeList,
+								"key": {
+									Type:        schema.TypeString,
 									Optional:    true,
-									MaxItems:    1,
-									Description: "Selects a key of a ConfigMap.",
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"key": {
-												Type:        schema.TypeString,
-												Optional:    true,
-												Description: "The key to select.",
-											},
-											"name": {
-												Type:        schema.TypeString,
-												Optional:    true,
-												Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
-											},
-										},
-									},
+									Description: "The key to select.",
 								},
-								"field_ref": {
-									Type:        schema.TypeList,
+								"name": {
+									Type:        schema.TypeString,
 									Optional:    true,
-									MaxItems:    1,
-									Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"api_version": {
-												Type:        schema.TypeString,
-												Optional:    true,
-												Default:     "v1",
-												Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
-											},
-											"field_path": {
-												Type:        schema.TypeString,
-												Optional:    true,
-												Description: "Path of the field to select in the specified API version",
-											},
-										},
-									},
+									Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
 								},
-								"resource_field_ref": {
-									Type:        schema.TypeList,
+							},
+						},
+					},
+					"field_ref": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						MaxItems:    1,
+						Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"api_version": {
+									Type:        schema.TypeString,
 									Optional:    true,
-									MaxItems:    1,
-									Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"container_name": {
-												Type:     schema.TypeString,
-												Optional: true,
-											},
-											"resource": {
-												Type:        schema.TypeString,
-												Required:    true,
-												Description: "Resource to select",
-											},
-										},
-									},
+									Default:     "v1",
+									Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
 								},
-								"secret_key_ref": {
-									Type:        schema.TypeList,
+								"field_path": {
+									Type:        schema.TypeString,
 									Optional:    true,
-									MaxItems:    1,
-									Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											"key": {
-												Type:        schema.TypeString,
-												Optional:    true,
-												Description: "The key of the secret to select from. Must be a valid secret key.",
-											},
-											"name": {
-												Type:        schema.TypeString,
-												Optional:    true,
-												Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
-											},
-										},
-									},
+									Description: "Path of the field to select in the specified API version",
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		"env_from": {
-			Type:        schema.TypeList,
-			Optional:    true,
-			ForceNew:    !isUpdatable,
-			Description: "List of environment variables to set in the container. Cannot be updated.",
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"prefix": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Description: "An optional identifer to prepend to each key in the ConfigMap. Must be a C_IDENTIFIER.",
-					},
-					"config_map_ref": {
+					"resource_field_ref": {
 						Type:        schema.TypeList,
 						Optional:    true,
 						MaxItems:    1,
-						Description: "Populate ENV from ConfigMap.",
+						Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
-								"name": {
-									Type:        schema.TypeString,
-									Optional:    true,
-									Description: "Name of the ConfigMap. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+								"container_name": {
+									Type:     schema.TypeString,
+									Optional: true,
 								},
-								"optional": {
-									Type:        schema.TypeBool,
-									Optional:    true,
-									Description: "Specify whether the ConfigMap must be defined",
+								"resource": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Resource to select",
 								},
 							},
 						},
 					},
-					"secret_ref": {
+					"secret_key_ref": {
 						Type:        schema.TypeList,
 						Optional:    true,
 						MaxItems:    1,
-						Description: "Populate multiple ENV variables from Secret.",
+						Description: "Selects a field of the pod: supports metadata.name, metadata.namespace, metadata.labels, metadata.annotations, spec.nodeName, spec.serviceAccountName, status.podIP..",
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
-								"name": {
+								"key": {
 									Type:        schema.TypeString,
 									Optional:    true,
-									Description: "Name of the Secret. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+									Description: "The key of the secret to select from. Must be a valid secret key.",
 								},
-								"optional": {
-									Type:        schema.TypeBool,
+								"name": {
+									Type:        schema.TypeString,
 									Optional:    true,
-									Description: "Specify whether the Secret must be defined",
+									Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
 								},
 							},
 						},
@@ -373,6 +330,91 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 				},
 			},
 		},
+	}
+}
+
+func envFromFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An optional identifer to prepend to each key in the ConfigMap. Must be a C_IDENTIFIER.",
+		},
+		"config_map_ref": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Populate ENV from ConfigMap.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Name of the ConfigMap. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+					},
+					"optional": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Specify whether the ConfigMap must be defined",
+					},
+				},
+			},
+		},
+		"secret_ref": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Populate multiple ENV variables from Secret.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Name of the Secret. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+					},
+					"optional": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Description: "Specify whether the Secret must be defined",
+					},
+				},
+			},
+		},
+	}
+}
+
+func containerFields(isUpdatable bool) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"args": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/containers#containers-and-commands",
+		},
+		"command": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/containers#containers-and-commands",
+		},
+		"env": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "List of environment variables to set in the container. Cannot be updated.",
+			Elem: &schema.Resource{
+				Schema: envFields(),
+			},
+		},
+		"env_from": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "List of environment variables to set in the container. Cannot be updated.",
+			Elem: &schema.Resource{
+				Schema: envFromFields(),
+			},
+		},
 		"image": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -513,6 +555,14 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 			Default:     false,
 			Description: "Whether this container should allocate a TTY for itself",
 		},
+		"volume_device": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Block devices to be used by the container. The referenced volume must be a persistent volume claim in `Block` volume mode.",
+			Elem: &schema.Resource{
+				Schema: volumeDeviceFields(),
+			},
+		},
 		"volume_mount": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -0,0 +1,51 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMigrateContainerResourcesState(t *testing.T) {
+	is := &terraform.InstanceState{
+		ID: "default/test",
+		Attributes: map[string]string{
+			"metadata.0.name":                                     "test",
+			"spec.0.container.#":                                  "1",
+			"spec.0.container.0.resources.#":                      "1",
+			"spec.0.container.0.resources.0.limits.#":             "1",
+			"spec.0.container.0.resources.0.limits.0.cpu":         "500m",
+			"spec.0.container.0.resources.0.limits.0.memory":      "512Mi",
+			"spec.0.container.0.resources.0.requests.#":           "1",
+			"spec.0.container.0.resources.0.requests.0.cpu":       "250m",
+			"spec.0.container.0.resources.0.requests.0.memory":    "",
+			"spec.0.init_container.#":                             "1",
+			"spec.0.init_container.0.resources.#":                 "1",
+			"spec.0.init_container.0.resources.0.limits.#":        "1",
+			"spec.0.init_container.0.resources.0.limits.0.cpu":    "",
+			"spec.0.init_container.0.resources.0.limits.0.memory": "",
+		},
+	}
+
+	expected := map[string]string{
+		"metadata.0.name":                              "test",
+		"spec.0.container.#":                           "1",
+		"spec.0.container.0.resources.#":               "1",
+		"spec.0.container.0.resources.0.limits.%":      "2",
+		"spec.0.container.0.resources.0.limits.cpu":    "500m",
+		"spec.0.container.0.resources.0.limits.memory": "512Mi",
+		"spec.0.container.0.resources.0.requests.%":    "1",
+		"spec.0.container.0.resources.0.requests.cpu":  "250m",
+		"spec.0.init_container.#":                      "1",
+		"spec.0.init_container.0.resources.#":          "1",
+	}
+
+	out, err := migrateContainerResourcesState(is)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Attributes, expected) {
+		t.Fatalf("unexpected attributes after migration:\nexpected: %#v\ngot: %#v", expected, out.Attributes)
+	}
+}
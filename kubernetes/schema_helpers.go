@@ -0,0 +1,20 @@
+package kubernetes
+
+import "github.com/hashicorp/terraform/helper/schema"
+
+// forceNewFields marks every configurable field of the given schema as
+// ForceNew, including those of nested blocks. ForceNew on a block does not
+// carry down to its fields, so a change nested inside an immutable block would
+// otherwise be planned as an in-place update.
+func forceNewFields(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for _, v := range s {
+		if v.Computed && !v.Optional {
+			continue
+		}
+		v.ForceNew = true
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			forceNewFields(r.Schema)
+		}
+	}
+	return s
+}
@@ -0,0 +1,45 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestForceNewFields(t *testing.T) {
+	s := forceNewFields(map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"uid": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"block": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	})
+
+	if !s["name"].ForceNew {
+		t.Error("expected name to be ForceNew")
+	}
+	if s["uid"].ForceNew {
+		t.Error("expected computed only uid not to be ForceNew")
+	}
+	if !s["block"].ForceNew {
+		t.Error("expected block to be ForceNew")
+	}
+	if !s["block"].Elem.(*schema.Resource).Schema["key"].ForceNew {
+		t.Error("expected nested key to be ForceNew")
+	}
+}
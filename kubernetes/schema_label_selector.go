@@ -1,31 +1,40 @@
 package kubernetes
 
 import (
+	"log"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
-func labelSelectorFields() map[string]*schema.Schema {
+func labelSelectorFields(updatable bool) map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"match_expressions": {
 			Type:        schema.TypeList,
 			Description: "A list of label selector requirements. The requirements are ANDed.",
 			Optional:    true,
+			ForceNew:    !updatable,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"key": {
 						Type:        schema.TypeString,
 						Description: "The label key that the selector applies to.",
 						Optional:    true,
+						ForceNew:    !updatable,
 					},
 					"operator": {
 						Type:        schema.TypeString,
 						Description: "A key's relationship to a set of values. Valid operators ard `In`, `NotIn`, `Exists` and `DoesNotExist`.",
 						Optional:    true,
+						ForceNew:    !updatable,
 					},
 					"values": {
 						Type:        schema.TypeSet,
 						Description: "An array of string values. If the operator is `In` or `NotIn`, the values array must be non-empty. If the operator is `Exists` or `DoesNotExist`, the values array must be empty. This array is replaced during a strategic merge patch.",
 						Optional:    true,
+						ForceNew:    !updatable,
 						Elem:        &schema.Schema{Type: schema.TypeString},
 						Set:         schema.HashString,
 					},
@@ -36,6 +45,43 @@ func labelSelectorFields() map[string]*schema.Schema {
 			Type:        schema.TypeMap,
 			Description: "A map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of `match_expressions`, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
 			Optional:    true,
+			ForceNew:    !updatable,
 		},
 	}
 }
+
+// Workload selectors used to be plain maps of labels. This migration moves
+// them into the match_labels of a single label selector block found at key,
+// e.g. spec.0.selector.app -> spec.0.selector.0.match_labels.app.
+func migrateSelectorMapState(is *terraform.InstanceState, key string) (*terraform.InstanceState, error) {
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	prefix := key + "."
+	newAttributes := make(map[string]string)
+
+	for k, v := range is.Attributes {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		delete(is.Attributes, k)
+		label := strings.TrimPrefix(k, prefix)
+		if label == "%" {
+			continue
+		}
+
+		newK := prefix + "0.match_labels." + label
+		newAttributes[newK] = v
+		log.Printf("[DEBUG] moved attribute %s -> %s ", k, newK)
+	}
+
+	if len(newAttributes) > 0 {
+		for k, v := range newAttributes {
+			is.Attributes[k] = v
+		}
+		is.Attributes[prefix+"#"] = "1"
+		is.Attributes[prefix+"0.match_labels.%"] = strconv.Itoa(len(newAttributes))
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
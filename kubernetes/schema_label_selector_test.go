@@ -0,0 +1,40 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMigrateSelectorMapState(t *testing.T) {
+	is := &terraform.InstanceState{
+		ID: "default/test",
+		Attributes: map[string]string{
+			"metadata.0.name":                         "test",
+			"spec.0.selector.%":                       "2",
+			"spec.0.selector.app":                     "web",
+			"spec.0.selector.app.kubernetes.io/name":  "web",
+			"spec.0.template.0.metadata.0.labels.%":   "1",
+			"spec.0.template.0.metadata.0.labels.app": "web",
+		},
+	}
+
+	expected := map[string]string{
+		"metadata.0.name":                                       "test",
+		"spec.0.selector.#":                                     "1",
+		"spec.0.selector.0.match_labels.%":                      "2",
+		"spec.0.selector.0.match_labels.app":                    "web",
+		"spec.0.selector.0.match_labels.app.kubernetes.io/name": "web",
+		"spec.0.template.0.metadata.0.labels.%":                 "1",
+		"spec.0.template.0.metadata.0.labels.app":               "web",
+	}
+
+	out, err := migrateSelectorMapState(is, "spec.0.selector")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.Attributes, expected) {
+		t.Fatalf("unexpected attributes after migration:\nexpected: %#v\ngot: %#v", expected, out.Attributes)
+	}
+}
@@ -9,7 +9,7 @@ func persistentVolumeClaimSpecFields(pvcTemplate bool) map[string]*schema.Schema
 			Type:        schema.TypeList,
 			Description: "Spec defines the desired characteristics of a volume requested by a pod author. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#persistentvolumeclaims",
 			Required:    true,
-			ForceNew:    true,
+			ForceNew:    pvcTemplate,
 			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -25,7 +25,7 @@ func persistentVolumeClaimSpecFields(pvcTemplate bool) map[string]*schema.Schema
 						Type:        schema.TypeList,
 						Description: "A list of the minimum resources the volume should have. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#resources",
 						Required:    true,
-						ForceNew:    true,
+						ForceNew:    pvcTemplate,
 						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
@@ -39,7 +39,11 @@ func persistentVolumeClaimSpecFields(pvcTemplate bool) map[string]*schema.Schema
 									Type:        schema.TypeMap,
 									Description: "Map describing the minimum amount of compute resources required. If this is omitted for a container, it defaults to `limits` if that is explicitly specified, otherwise to an implementation-defined value. More info: http://kubernetes.io/docs/user-guide/compute-resources/",
 									Optional:    true,
-									ForceNew:    true,
+									// Storage increases are applied in place when the storage
+									// class allows volume expansion, see
+									// resourceKubernetesPersistentVolumeClaimCustomizeDiff.
+									ForceNew:         pvcTemplate,
+									DiffSuppressFunc: suppressEquivalentResourceQuantity,
 								},
 							},
 						},
@@ -105,6 +109,14 @@ func persistentVolumeClaimSpecFields(pvcTemplate bool) map[string]*schema.Schema
 						Computed:    true,
 						ForceNew:    true,
 					},
+					"volume_mode": {
+						Type:         schema.TypeString,
+						Description:  "Defines what type of volume is required by the claim. One of `Filesystem` or `Block`. Defaults to `Filesystem`.",
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validateAttributeValueIsIn([]string{"Filesystem", "Block"}),
+					},
 				},
 			},
 		},
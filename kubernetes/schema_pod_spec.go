@@ -79,6 +79,26 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 			Default:     "ClusterFirst",
 			Description: "Set DNS policy for containers within the pod. One of 'ClusterFirst' or 'Default'. Defaults to 'ClusterFirst'.",
 		},
+		"host_aliases": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of hosts and IPs that will be injected into the pod's hosts file if specified. This is only valid for non-hostNetwork pods.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"hostnames": {
+						Type:        schema.TypeList,
+						Required:    true,
+						Description: "Hostnames for the IP address.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"ip": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "IP address of the host file entry.",
+					},
+				},
+			},
+		},
 		"host_ipc": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -139,6 +159,31 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 			Optional:    true,
 			Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: http://kubernetes.io/docs/user-guide/node-selection.",
 		},
+		"priority": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The priority value. Various system components use this field to find the priority of the pod. It is populated from `priority_class_name` by the Priority Admission Controller. The higher the value, the higher the priority.",
+		},
+		"priority_class_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "If specified, indicates the pod's priority. \"system-node-critical\" and \"system-cluster-critical\" are two special keywords which indicate the highest priorities. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default.",
+		},
+		"readiness_gates": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "If specified, all readiness gates will be evaluated for pod readiness. A pod is ready when all its containers are ready AND all conditions specified in the readiness gates have status equal to \"True\". More info: https://github.com/kubernetes/community/blob/master/keps/sig-network/0007-pod-ready%2B%2B.md",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"condition_type": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Refers to a condition in the pod's condition list with matching type.",
+					},
+				},
+			},
+		},
 		"restart_policy": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -157,6 +202,12 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 						Description: "A special supplemental group that applies to all containers in a pod. Some volume types allow the Kubelet to change the ownership of that volume to be owned by the pod: 1. The owning GID will be the FSGroup 2. The setgid bit is set (new files created in the volume will be owned by FSGroup) 3. The permission bits are OR'd with rw-rw---- If unset, the Kubelet will not modify the ownership and permissions of any volume.",
 						Optional:    true,
 					},
+					"run_as_group": {
+						Type:        schema.TypeInt,
+						Description: "The GID to run the entrypoint of the container process. Uses runtime default if unset or set to 0. May also be set in SecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence for that container.",
+						Optional:    true,
+						Computed:    true,
+					},
 					"run_as_non_root": {
 						Type:        schema.TypeBool,
 						Description: "Indicates that the container must run as a non-root user. If true, the Kubelet will validate the image at runtime to ensure that it does not run as UID 0 (root) and fail to start the container if it does.",
@@ -184,9 +235,34 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 							Schema: seLinuxOptionsField(),
 						},
 					},
+					"sysctls": {
+						Type:        schema.TypeList,
+						Description: "Namespaced sysctls used for the pod. Pods with unsupported sysctls (by the container runtime) might fail to launch.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Description: "Name of a property to set.",
+									Required:    true,
+								},
+								"value": {
+									Type:        schema.TypeString,
+									Description: "Value of a property to set.",
+									Required:    true,
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		"scheduler_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "If specified, the pod will be dispatched by specified scheduler. If not specified, the pod will be dispatched by default scheduler.",
+		},
 		"service_account_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -199,6 +275,12 @@ func podSpecFields(isUpdatable bool) map[string]*schema.Schema {
 			Default:     true,
 			Description: "In version 1.6+, you can also opt out of automounting API credentials for a particular pod",
 		},
+		"share_process_namespace": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Share a single process namespace between all of the containers in a pod. When this is set containers will be able to view and signal processes from other containers in the same pod, and the first process in each container will not be assigned PID 1.",
+		},
 		"subdomain": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -368,63 +450,7 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 					Description: `If unspecified, each key-value pair in the Data field of the referenced ConfigMap will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. If a key is specified which is not present in the ConfigMap, the volume setup will error. Paths must be relative and may not contain the '..' path or start with '..'.`,
 					Optional:    true,
 					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"field_ref": {
-								Type:        schema.TypeList,
-								Required:    true,
-								MaxItems:    1,
-								Description: "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.",
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"api_version": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											Default:     "v1",
-											Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
-										},
-										"field_path": {
-											Type:        schema.TypeString,
-											Optional:    true,
-											Description: "Path of the field to select in the specified API version",
-										},
-									},
-								},
-							},
-							"mode": {
-								Type:        schema.TypeInt,
-								Optional:    true,
-								Description: `Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.`,
-							},
-							"path": {
-								Type:         schema.TypeString,
-								Required:     true,
-								ValidateFunc: validateAttributeValueDoesNotContain(".."),
-								Description:  `Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'`,
-							},
-							"resource_field_ref": {
-								Type:        schema.TypeList,
-								Optional:    true,
-								MaxItems:    1,
-								Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.",
-								Elem: &schema.Resource{
-									Schema: map[string]*schema.Schema{
-										"container_name": {
-											Type:     schema.TypeString,
-											Required: true,
-										},
-										"quantity": {
-											Type:     schema.TypeString,
-											Optional: true,
-										},
-										"resource": {
-											Type:        schema.TypeString,
-											Required:    true,
-											Description: "Resource to select",
-										},
-									},
-								},
-							},
-						},
+						Schema: downwardAPIVolumeFileFields(),
 					},
 				},
 			},
@@ -479,6 +505,97 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 		},
 	}
 
+	v["projected"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Items for all in one resources secrets, configmaps, downward API and service account tokens. More info: https://kubernetes.io/docs/concepts/storage/volumes/#projected",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"default_mode": {
+					Type:         schema.TypeInt,
+					Description:  "Optional: mode bits to use on created files by default. Must be a value between 0 and 0777. Defaults to 0644. Directories within the path are not affected by this setting. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.",
+					Optional:     true,
+					Default:      0644,
+					ValidateFunc: validateModeBits,
+				},
+				"sources": {
+					Type:        schema.TypeList,
+					Description: "Volume projections. Each entry must set exactly one of `config_map`, `downward_api`, `secret` or `service_account_token`.",
+					Required:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"config_map": {
+								Type:        schema.TypeList,
+								Description: "Information about the ConfigMap data to project.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: projectionFields(),
+								},
+							},
+							"downward_api": {
+								Type:        schema.TypeList,
+								Description: "Information about the downward API data to project.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"items": {
+											Type:        schema.TypeList,
+											Description: "Represents a volume containing downward API info.",
+											Optional:    true,
+											Elem: &schema.Resource{
+												Schema: downwardAPIVolumeFileFields(),
+											},
+										},
+									},
+								},
+							},
+							"secret": {
+								Type:        schema.TypeList,
+								Description: "Information about the Secret data to project.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: projectionFields(),
+								},
+							},
+							"service_account_token": {
+								Type:        schema.TypeList,
+								Description: "Information about the service account token to project.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"audience": {
+											Type:        schema.TypeString,
+											Description: "The intended audience of the token. A recipient of a token must identify itself with an identifier specified in the audience of the token, and otherwise should reject the token. Defaults to the identifier of the API server.",
+											Optional:    true,
+										},
+										"expiration_seconds": {
+											Type:         schema.TypeInt,
+											Description:  "The requested duration of validity of the service account token. As the token approaches expiration, the kubelet volume plugin will proactively rotate the service account token. Must be at least 600 seconds. Defaults to 1 hour.",
+											Optional:     true,
+											Default:      3600,
+											ValidateFunc: validateProjectedTokenExpiration,
+										},
+										"path": {
+											Type:         schema.TypeString,
+											Description:  "The path relative to the mount point of the file to project the token into.",
+											Required:     true,
+											ValidateFunc: validateAttributeValueDoesNotContain(".."),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
 	v["secret"] = &schema.Schema{
 		Type:        schema.TypeList,
 		Description: "Secret represents a secret that should populate this volume. More info: http://kubernetes.io/docs/user-guide/volumes#secrets",
@@ -540,3 +657,105 @@ func volumeSchema(isUpdatable bool) *schema.Resource {
 		Schema: v,
 	}
 }
+
+func downwardAPIVolumeFileFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"field_ref": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_version": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "v1",
+						Description: `Version of the schema the FieldPath is written in terms of, defaults to "v1".`,
+					},
+					"field_path": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Path of the field to select in the specified API version",
+					},
+				},
+			},
+		},
+		"mode": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.`,
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateAttributeValueDoesNotContain(".."),
+			Description:  `Path is the relative path name of the file to be created. Must not be absolute or contain the '..' path. Must be utf-8 encoded. The first item of the relative path must not start with '..'`,
+		},
+		"resource_field_ref": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"container_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"quantity": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"resource": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Resource to select",
+					},
+				},
+			},
+		},
+	}
+}
+
+// projectionFields are the fields shared by the config map and secret
+// projections of a projected volume.
+func projectionFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"items": {
+			Type:        schema.TypeList,
+			Description: "If unspecified, each key-value pair in the Data field of the referenced object will be projected into the volume as a file whose name is the key and content is the value. If specified, the listed keys will be projected into the specified paths, and unlisted keys will not be present. Paths must be relative and may not contain the '..' path or start with '..'.",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The key to project.",
+					},
+					"mode": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Optional: mode bits to use on this file, must be a value between 0 and 0777. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.",
+					},
+					"path": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateAttributeValueDoesNotContain(".."),
+						Description:  "The relative path of the file to map the key to. May not be an absolute path. May not contain the path element '..'. May not start with the string '..'.",
+					},
+				},
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+			Optional:    true,
+		},
+		"optional": {
+			Type:        schema.TypeBool,
+			Description: "Optional: Specify whether the referenced object or its keys must be defined.",
+			Optional:    true,
+		},
+	}
+}
@@ -42,6 +42,20 @@ func policyRuleFields() map[string]*schema.Schema {
 	return s
 }
 
+func aggregationRuleFields() map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"cluster_role_selectors": {
+			Type:        schema.TypeList,
+			Description: "ClusterRoleSelectors holds a list of selectors which will be used to find ClusterRoles and create the rules. If any of the selectors match, then the ClusterRole's permissions will be added",
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
+	}
+	return s
+}
+
 func roleRefFields() map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
 		"api_group": {
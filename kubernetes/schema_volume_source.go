@@ -5,8 +5,111 @@ import (
 )
 
 func persistentVolumeSourceSchema() *schema.Resource {
+	v := commonVolumeSources()
+
+	v["csi"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Represents storage that is handled by an external CSI driver. More info: https://kubernetes.io/docs/concepts/storage/volumes/#csi",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"controller_publish_secret_ref": {
+					Type:        schema.TypeList,
+					Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI ControllerPublishVolume and ControllerUnpublishVolume calls.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: secretReferenceFields(),
+					},
+				},
+				"driver": {
+					Type:        schema.TypeString,
+					Description: "The name of the driver to use for this volume.",
+					Required:    true,
+				},
+				"fs_type": {
+					Type:        schema.TypeString,
+					Description: "Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. \"ext4\", \"xfs\", \"ntfs\".",
+					Optional:    true,
+				},
+				"node_publish_secret_ref": {
+					Type:        schema.TypeList,
+					Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodePublishVolume and NodeUnpublishVolume calls.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: secretReferenceFields(),
+					},
+				},
+				"node_stage_secret_ref": {
+					Type:        schema.TypeList,
+					Description: "A reference to the secret object containing sensitive information to pass to the CSI driver to complete the CSI NodeStageVolume and NodeUnstageVolume calls.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: secretReferenceFields(),
+					},
+				},
+				"read_only": {
+					Type:        schema.TypeBool,
+					Description: "Whether to set the read-only property in VolumeMounts to \"true\". If omitted, the default is \"false\".",
+					Optional:    true,
+				},
+				"volume_attributes": {
+					Type:        schema.TypeMap,
+					Description: "Attributes of the volume to publish.",
+					Optional:    true,
+				},
+				"volume_handle": {
+					Type:        schema.TypeString,
+					Description: "A string value that uniquely identifies the volume. Must be the same value returned by the CSI driver in the CreateVolumeResponse.",
+					Required:    true,
+				},
+			},
+		},
+	}
+	v["local"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Represents directly-attached storage with node affinity. More info: https://kubernetes.io/docs/concepts/storage/volumes/#local",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"path": {
+					Type:        schema.TypeString,
+					Description: "The full path to the volume on the node. It can be either a directory or block device (disk, partition, ...).",
+					Required:    true,
+				},
+			},
+		},
+	}
+
+	// Unlike their pod volume counterparts, the CephFS and RBD persistent
+	// volume sources may reference a secret in another namespace
+	for _, k := range []string{"ceph_fs", "rbd"} {
+		v[k].Elem.(*schema.Resource).Schema["secret_ref"].Elem = &schema.Resource{
+			Schema: secretReferenceFields(),
+		}
+	}
+
 	return &schema.Resource{
-		Schema: commonVolumeSources(),
+		Schema: v,
+	}
+}
+
+func secretReferenceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "Name of the referent. More info: http://kubernetes.io/docs/user-guide/identifiers#names",
+			Optional:    true,
+		},
+		"namespace": {
+			Type:        schema.TypeString,
+			Description: "Namespace of the referent. Defaults to the namespace of the persistent volume claim.",
+			Optional:    true,
+		},
 	}
 }
 
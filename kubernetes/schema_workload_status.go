@@ -0,0 +1,232 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func workloadConditionFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"last_transition_time": {
+			Type:        schema.TypeString,
+			Description: "Last time the condition transitioned from one status to another.",
+			Computed:    true,
+		},
+		"message": {
+			Type:        schema.TypeString,
+			Description: "A human readable message indicating details about the transition.",
+			Computed:    true,
+		},
+		"reason": {
+			Type:        schema.TypeString,
+			Description: "The reason for the condition's last transition.",
+			Computed:    true,
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "Status of the condition, one of `True`, `False` or `Unknown`.",
+			Computed:    true,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "Type of the condition.",
+			Computed:    true,
+		},
+	}
+}
+
+func workloadStatusSchema(description string, fields map[string]*schema.Schema) *schema.Schema {
+	fields["condition"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The latest available observations of the current state.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: workloadConditionFields(),
+		},
+	}
+	fields["observed_generation"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The generation observed by the controller. The status is up to date when this matches `metadata.0.generation`.",
+		Computed:    true,
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func computedIntField(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: description,
+		Computed:    true,
+	}
+}
+
+func deploymentStatusSchema() *schema.Schema {
+	return workloadStatusSchema("The most recently observed status of the deployment.", map[string]*schema.Schema{
+		"available_replicas":   computedIntField("Total number of available pods (ready for at least `min_ready_seconds`) targeted by this deployment."),
+		"ready_replicas":       computedIntField("Total number of ready pods targeted by this deployment."),
+		"replicas":             computedIntField("Total number of non-terminated pods targeted by this deployment."),
+		"unavailable_replicas": computedIntField("Total number of unavailable pods targeted by this deployment."),
+		"updated_replicas":     computedIntField("Total number of non-terminated pods targeted by this deployment that have the desired template spec."),
+		"revision": {
+			Type:        schema.TypeString,
+			Description: "The revision of the current rollout of the deployment.",
+			Computed:    true,
+		},
+	})
+}
+
+func statefulSetStatusSchema() *schema.Schema {
+	return workloadStatusSchema("The most recently observed status of the stateful set.", map[string]*schema.Schema{
+		"current_replicas": computedIntField("Number of pods created by the stateful set controller from the version indicated by `current_revision`."),
+		"ready_replicas":   computedIntField("Number of pods created by the stateful set controller that have a Ready condition."),
+		"replicas":         computedIntField("Number of pods created by the stateful set controller."),
+		"updated_replicas": computedIntField("Number of pods created by the stateful set controller from the version indicated by `update_revision`."),
+		"current_revision": {
+			Type:        schema.TypeString,
+			Description: "The version of the stateful set used to generate pods in the sequence [0, `current_replicas`).",
+			Computed:    true,
+		},
+		"update_revision": {
+			Type:        schema.TypeString,
+			Description: "The version of the stateful set used to generate pods in the sequence [`replicas` - `updated_replicas`, `replicas`).",
+			Computed:    true,
+		},
+	})
+}
+
+func daemonSetStatusSchema() *schema.Schema {
+	return workloadStatusSchema("The most recently observed status of the daemon set.", map[string]*schema.Schema{
+		"current_number_scheduled": computedIntField("Number of nodes running at least one daemon pod and that are supposed to run the daemon pod."),
+		"desired_number_scheduled": computedIntField("Total number of nodes that should be running the daemon pod."),
+		"number_available":         computedIntField("Number of nodes that should be running the daemon pod and have one or more of the daemon pod running and available."),
+		"number_misscheduled":      computedIntField("Number of nodes running the daemon pod that are not supposed to run it."),
+		"number_ready":             computedIntField("Number of nodes that should be running the daemon pod and have one or more of the daemon pod running and ready."),
+		"number_unavailable":       computedIntField("Number of nodes that should be running the daemon pod and have none of the daemon pod running and available."),
+		"updated_number_scheduled": computedIntField("Total number of nodes that are running the updated daemon pod."),
+	})
+}
+
+func replicationControllerStatusSchema() *schema.Schema {
+	return workloadStatusSchema("The most recently observed status of the replication controller.", map[string]*schema.Schema{
+		"available_replicas":     computedIntField("Number of available replicas (ready for at least `min_ready_seconds`) for this replication controller."),
+		"fully_labeled_replicas": computedIntField("Number of pods that have labels matching the labels of the pod template of the replication controller."),
+		"ready_replicas":         computedIntField("Number of ready replicas for this replication controller."),
+		"replicas":               computedIntField("The most recently observed number of replicas."),
+	})
+}
+
+func jobStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The most recently observed status of the job.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"active":    computedIntField("Number of actively running pods."),
+				"failed":    computedIntField("Number of pods which reached phase Failed."),
+				"succeeded": computedIntField("Number of pods which reached phase Succeeded."),
+				"start_time": {
+					Type:        schema.TypeString,
+					Description: "Time when the job was acknowledged by the job controller, in RFC3339 format.",
+					Computed:    true,
+				},
+				"completion_time": {
+					Type:        schema.TypeString,
+					Description: "Time when the job was completed, in RFC3339 format. Only set once the job finished successfully.",
+					Computed:    true,
+				},
+				"condition": {
+					Type:        schema.TypeList,
+					Description: "The latest available observations of the job's current state.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: workloadConditionFields(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func podStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The most recently observed status of the pod.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"phase": {
+					Type:        schema.TypeString,
+					Description: "The phase of the pod, one of `Pending`, `Running`, `Succeeded`, `Failed` or `Unknown`.",
+					Computed:    true,
+				},
+				"pod_ip": {
+					Type:        schema.TypeString,
+					Description: "IP address allocated to the pod.",
+					Computed:    true,
+				},
+				"host_ip": {
+					Type:        schema.TypeString,
+					Description: "IP address of the host to which the pod is assigned.",
+					Computed:    true,
+				},
+				"qos_class": {
+					Type:        schema.TypeString,
+					Description: "The Quality of Service class assigned to the pod based on its resource requirements, one of `Guaranteed`, `Burstable` or `BestEffort`.",
+					Computed:    true,
+				},
+				"container_status": {
+					Type:        schema.TypeList,
+					Description: "The status of each container in the pod.",
+					Computed:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Description: "Name of the container.",
+								Computed:    true,
+							},
+							"image": {
+								Type:        schema.TypeString,
+								Description: "The image the container is running.",
+								Computed:    true,
+							},
+							"image_id": {
+								Type:        schema.TypeString,
+								Description: "ID of the image the container is running.",
+								Computed:    true,
+							},
+							"container_id": {
+								Type:        schema.TypeString,
+								Description: "ID of the container in the format `<type>://<container_id>`.",
+								Computed:    true,
+							},
+							"ready": {
+								Type:        schema.TypeBool,
+								Description: "Whether the container has passed its readiness probe.",
+								Computed:    true,
+							},
+							"restart_count": computedIntField("The number of times the container has been restarted."),
+							"state": {
+								Type:        schema.TypeString,
+								Description: "Current state of the container, one of `waiting`, `running` or `terminated`.",
+								Computed:    true,
+							},
+							"reason": {
+								Type:        schema.TypeString,
+								Description: "Brief reason the container is waiting or terminated, e.g. `CrashLoopBackOff`.",
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	api "k8s.io/api/certificates/v1beta1"
+)
+
+func expandCertificateSigningRequestSpec(in []interface{}) api.CertificateSigningRequestSpec {
+	spec := api.CertificateSigningRequestSpec{}
+	if len(in) == 0 || in[0] == nil {
+		return spec
+	}
+	m := in[0].(map[string]interface{})
+
+	if v, ok := m["request"].(string); ok {
+		spec.Request = []byte(v)
+	}
+	if v, ok := m["usages"].(*schema.Set); ok {
+		spec.Usages = expandCertificateSigningRequestUsages(v.List())
+	}
+
+	return spec
+}
+
+func expandCertificateSigningRequestUsages(s []interface{}) []api.KeyUsage {
+	out := make([]api.KeyUsage, len(s), len(s))
+	for i, v := range s {
+		out[i] = api.KeyUsage(v.(string))
+	}
+	return out
+}
+
+func flattenCertificateSigningRequestSpec(in api.CertificateSigningRequestSpec) []interface{} {
+	m := make(map[string]interface{})
+	m["request"] = string(in.Request)
+
+	usages := make([]string, len(in.Usages), len(in.Usages))
+	for i, u := range in.Usages {
+		usages[i] = string(u)
+	}
+	m["usages"] = newStringSet(schema.HashString, usages)
+
+	return []interface{}{m}
+}
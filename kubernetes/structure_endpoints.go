@@ -0,0 +1,118 @@
+package kubernetes
+
+import (
+	api "k8s.io/api/core/v1"
+)
+
+// Expanders
+
+func expandEndpointSubsets(in []interface{}) []api.EndpointSubset {
+	if len(in) == 0 {
+		return []api.EndpointSubset{}
+	}
+	subsets := make([]api.EndpointSubset, len(in))
+	for i, v := range in {
+		if v == nil {
+			continue
+		}
+		m := v.(map[string]interface{})
+		if addrs, ok := m["address"].([]interface{}); ok && len(addrs) > 0 {
+			subsets[i].Addresses = expandEndpointAddresses(addrs)
+		}
+		if addrs, ok := m["not_ready_address"].([]interface{}); ok && len(addrs) > 0 {
+			subsets[i].NotReadyAddresses = expandEndpointAddresses(addrs)
+		}
+		if ports, ok := m["port"].([]interface{}); ok && len(ports) > 0 {
+			subsets[i].Ports = expandEndpointPorts(ports)
+		}
+	}
+	return subsets
+}
+
+func expandEndpointAddresses(in []interface{}) []api.EndpointAddress {
+	addrs := make([]api.EndpointAddress, len(in))
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		if ip, ok := m["ip"].(string); ok {
+			addrs[i].IP = ip
+		}
+		if hostname, ok := m["hostname"].(string); ok {
+			addrs[i].Hostname = hostname
+		}
+		if nodeName, ok := m["node_name"].(string); ok && nodeName != "" {
+			addrs[i].NodeName = ptrToString(nodeName)
+		}
+	}
+	return addrs
+}
+
+func expandEndpointPorts(in []interface{}) []api.EndpointPort {
+	ports := make([]api.EndpointPort, len(in))
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		if name, ok := m["name"].(string); ok {
+			ports[i].Name = name
+		}
+		if port, ok := m["port"].(int); ok {
+			ports[i].Port = int32(port)
+		}
+		if protocol, ok := m["protocol"].(string); ok {
+			ports[i].Protocol = api.Protocol(protocol)
+		}
+	}
+	return ports
+}
+
+// Flatteners
+
+func flattenEndpointSubsets(in []api.EndpointSubset) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, s := range in {
+		m := make(map[string]interface{})
+		m["address"] = flattenEndpointAddresses(s.Addresses)
+		m["not_ready_address"] = flattenEndpointAddresses(s.NotReadyAddresses)
+		m["port"] = flattenEndpointPorts(s.Ports)
+		att[i] = m
+	}
+	return att
+}
+
+func flattenEndpointAddresses(in []api.EndpointAddress) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, a := range in {
+		m := make(map[string]interface{})
+		m["ip"] = a.IP
+		if a.Hostname != "" {
+			m["hostname"] = a.Hostname
+		}
+		if a.NodeName != nil {
+			m["node_name"] = *a.NodeName
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenEndpointPorts(in []api.EndpointPort) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, p := range in {
+		m := make(map[string]interface{})
+		if p.Name != "" {
+			m["name"] = p.Name
+		}
+		m["port"] = int(p.Port)
+		m["protocol"] = string(p.Protocol)
+		att[i] = m
+	}
+	return att
+}
+
+// countReadyEndpointAddresses returns the number of addresses ready to
+// receive traffic across all subsets.
+func countReadyEndpointAddresses(in []api.EndpointSubset) int {
+	n := 0
+	for _, s := range in {
+		n += len(s.Addresses)
+	}
+	return n
+}
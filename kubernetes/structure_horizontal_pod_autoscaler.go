@@ -1,8 +1,14 @@
 package kubernetes
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	api "k8s.io/api/autoscaling/v1"
+	"k8s.io/api/autoscaling/v2beta1"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func expandHorizontalPodAutoscalerSpec(in []interface{}) api.HorizontalPodAutoscalerSpec {
@@ -103,3 +109,302 @@ func patchHorizontalPodAutoscalerSpec(prefix string, pathPrefix string, d *schem
 
 	return ops
 }
+
+func flattenHorizontalPodAutoscalerStatus(status api.HorizontalPodAutoscalerStatus) []interface{} {
+	m := make(map[string]interface{}, 0)
+	m["current_replicas"] = int(status.CurrentReplicas)
+	m["desired_replicas"] = int(status.DesiredReplicas)
+	if status.LastScaleTime != nil {
+		m["last_scale_time"] = status.LastScaleTime.Format(time.RFC3339)
+	}
+	if status.CurrentCPUUtilizationPercentage != nil {
+		m["current_metric"] = []interface{}{
+			map[string]interface{}{
+				"type":                        string(v2beta1.ResourceMetricSourceType),
+				"name":                        string(v1.ResourceCPU),
+				"current_average_utilization": int(*status.CurrentCPUUtilizationPercentage),
+			},
+		}
+	}
+	return []interface{}{m}
+}
+
+// autoscaling/v2beta1
+
+func expandHorizontalPodAutoscalerV2beta1Spec(in []interface{}) (v2beta1.HorizontalPodAutoscalerSpec, error) {
+	spec := v2beta1.HorizontalPodAutoscalerSpec{}
+	if len(in) == 0 || in[0] == nil {
+		return spec, nil
+	}
+	m := in[0].(map[string]interface{})
+	if v, ok := m["max_replicas"]; ok {
+		spec.MaxReplicas = int32(v.(int))
+	}
+	if v, ok := m["min_replicas"].(int); ok && v > 0 {
+		spec.MinReplicas = ptrToInt32(int32(v))
+	}
+	if v, ok := m["scale_target_ref"]; ok {
+		spec.ScaleTargetRef = v2beta1.CrossVersionObjectReference(expandCrossVersionObjectReference(v.([]interface{})))
+	}
+	if v, ok := m["metric"].([]interface{}); ok && len(v) > 0 {
+		metrics, err := expandHorizontalPodAutoscalerMetrics(v)
+		if err != nil {
+			return spec, err
+		}
+		spec.Metrics = metrics
+	} else if v, ok := m["target_cpu_utilization_percentage"].(int); ok && v > 0 {
+		spec.Metrics = []v2beta1.MetricSpec{
+			{
+				Type: v2beta1.ResourceMetricSourceType,
+				Resource: &v2beta1.ResourceMetricSource{
+					Name:                     v1.ResourceCPU,
+					TargetAverageUtilization: ptrToInt32(int32(v)),
+				},
+			},
+		}
+	}
+
+	return spec, nil
+}
+
+func expandHorizontalPodAutoscalerMetrics(in []interface{}) ([]v2beta1.MetricSpec, error) {
+	metrics := make([]v2beta1.MetricSpec, len(in))
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		metrics[i].Type = v2beta1.MetricSourceType(m["type"].(string))
+
+		if v, ok := m["resource"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			r := v[0].(map[string]interface{})
+			src := &v2beta1.ResourceMetricSource{
+				Name: v1.ResourceName(r["name"].(string)),
+			}
+			if u, ok := r["target_average_utilization"].(int); ok && u > 0 {
+				src.TargetAverageUtilization = ptrToInt32(int32(u))
+			}
+			if q, ok := r["target_average_value"].(string); ok && q != "" {
+				value, err := resource.ParseQuantity(q)
+				if err != nil {
+					return metrics, err
+				}
+				src.TargetAverageValue = &value
+			}
+			metrics[i].Resource = src
+		}
+		if v, ok := m["pods"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			p := v[0].(map[string]interface{})
+			value, err := resource.ParseQuantity(p["target_average_value"].(string))
+			if err != nil {
+				return metrics, err
+			}
+			metrics[i].Pods = &v2beta1.PodsMetricSource{
+				MetricName:         p["metric_name"].(string),
+				TargetAverageValue: value,
+			}
+		}
+		if v, ok := m["object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			o := v[0].(map[string]interface{})
+			value, err := resource.ParseQuantity(o["target_value"].(string))
+			if err != nil {
+				return metrics, err
+			}
+			metrics[i].Object = &v2beta1.ObjectMetricSource{
+				Target:      v2beta1.CrossVersionObjectReference(expandCrossVersionObjectReference(o["target"].([]interface{}))),
+				MetricName:  o["metric_name"].(string),
+				TargetValue: value,
+			}
+		}
+		if v, ok := m["external"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			e := v[0].(map[string]interface{})
+			src := &v2beta1.ExternalMetricSource{
+				MetricName: e["metric_name"].(string),
+			}
+			if s, ok := e["metric_selector"].([]interface{}); ok && len(s) > 0 {
+				src.MetricSelector = expandLabelSelector(s)
+			}
+			if q, ok := e["target_value"].(string); ok && q != "" {
+				value, err := resource.ParseQuantity(q)
+				if err != nil {
+					return metrics, err
+				}
+				src.TargetValue = &value
+			}
+			if q, ok := e["target_average_value"].(string); ok && q != "" {
+				value, err := resource.ParseQuantity(q)
+				if err != nil {
+					return metrics, err
+				}
+				src.TargetAverageValue = &value
+			}
+			metrics[i].External = src
+		}
+
+		if err := validateHorizontalPodAutoscalerMetric(metrics[i]); err != nil {
+			return metrics, fmt.Errorf("spec.0.metric.%d: %s", i, err)
+		}
+	}
+	return metrics, nil
+}
+
+func validateHorizontalPodAutoscalerMetric(metric v2beta1.MetricSpec) error {
+	switch metric.Type {
+	case v2beta1.ResourceMetricSourceType:
+		if metric.Resource == nil {
+			return fmt.Errorf("a resource block is required for metric type %q", metric.Type)
+		}
+		if (metric.Resource.TargetAverageUtilization == nil) == (metric.Resource.TargetAverageValue == nil) {
+			return fmt.Errorf("exactly one of target_average_utilization or target_average_value must be set")
+		}
+	case v2beta1.PodsMetricSourceType:
+		if metric.Pods == nil {
+			return fmt.Errorf("a pods block is required for metric type %q", metric.Type)
+		}
+	case v2beta1.ObjectMetricSourceType:
+		if metric.Object == nil {
+			return fmt.Errorf("an object block is required for metric type %q", metric.Type)
+		}
+	case v2beta1.ExternalMetricSourceType:
+		if metric.External == nil {
+			return fmt.Errorf("an external block is required for metric type %q", metric.Type)
+		}
+		if (metric.External.TargetValue == nil) == (metric.External.TargetAverageValue == nil) {
+			return fmt.Errorf("exactly one of target_value or target_average_value must be set")
+		}
+	}
+	return nil
+}
+
+func flattenHorizontalPodAutoscalerV2beta1Spec(spec v2beta1.HorizontalPodAutoscalerSpec, d *schema.ResourceData) []interface{} {
+	m := make(map[string]interface{}, 0)
+	m["max_replicas"] = spec.MaxReplicas
+	if spec.MinReplicas != nil {
+		m["min_replicas"] = *spec.MinReplicas
+	}
+	m["scale_target_ref"] = flattenCrossVersionObjectReference(api.CrossVersionObjectReference(spec.ScaleTargetRef))
+
+	// A single CPU utilization metric is how autoscaling/v2beta1 represents
+	// target_cpu_utilization_percentage, so keep it there unless metric blocks
+	// are configured.
+	configured, _ := d.Get("spec.0.metric").([]interface{})
+	if len(configured) == 0 && isSingleCPUUtilizationMetric(spec.Metrics) {
+		m["target_cpu_utilization_percentage"] = *spec.Metrics[0].Resource.TargetAverageUtilization
+	} else {
+		m["metric"] = flattenHorizontalPodAutoscalerMetrics(spec.Metrics)
+	}
+	return []interface{}{m}
+}
+
+func isSingleCPUUtilizationMetric(metrics []v2beta1.MetricSpec) bool {
+	if len(metrics) != 1 {
+		return false
+	}
+	r := metrics[0].Resource
+	return metrics[0].Type == v2beta1.ResourceMetricSourceType && r != nil &&
+		r.Name == v1.ResourceCPU && r.TargetAverageUtilization != nil
+}
+
+func flattenHorizontalPodAutoscalerMetrics(in []v2beta1.MetricSpec) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, metric := range in {
+		m := map[string]interface{}{
+			"type": string(metric.Type),
+		}
+		if metric.Resource != nil {
+			r := map[string]interface{}{
+				"name": string(metric.Resource.Name),
+			}
+			if metric.Resource.TargetAverageUtilization != nil {
+				r["target_average_utilization"] = int(*metric.Resource.TargetAverageUtilization)
+			}
+			if metric.Resource.TargetAverageValue != nil {
+				r["target_average_value"] = metric.Resource.TargetAverageValue.String()
+			}
+			m["resource"] = []interface{}{r}
+		}
+		if metric.Pods != nil {
+			m["pods"] = []interface{}{
+				map[string]interface{}{
+					"metric_name":          metric.Pods.MetricName,
+					"target_average_value": metric.Pods.TargetAverageValue.String(),
+				},
+			}
+		}
+		if metric.Object != nil {
+			m["object"] = []interface{}{
+				map[string]interface{}{
+					"metric_name":  metric.Object.MetricName,
+					"target":       flattenCrossVersionObjectReference(api.CrossVersionObjectReference(metric.Object.Target)),
+					"target_value": metric.Object.TargetValue.String(),
+				},
+			}
+		}
+		if metric.External != nil {
+			e := map[string]interface{}{
+				"metric_name": metric.External.MetricName,
+			}
+			if metric.External.MetricSelector != nil {
+				e["metric_selector"] = flattenLabelSelector(metric.External.MetricSelector)
+			}
+			if metric.External.TargetValue != nil {
+				e["target_value"] = metric.External.TargetValue.String()
+			}
+			if metric.External.TargetAverageValue != nil {
+				e["target_average_value"] = metric.External.TargetAverageValue.String()
+			}
+			m["external"] = []interface{}{e}
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenHorizontalPodAutoscalerV2beta1Status(status v2beta1.HorizontalPodAutoscalerStatus) []interface{} {
+	m := make(map[string]interface{}, 0)
+	m["current_replicas"] = int(status.CurrentReplicas)
+	m["desired_replicas"] = int(status.DesiredReplicas)
+	if status.LastScaleTime != nil {
+		m["last_scale_time"] = status.LastScaleTime.Format(time.RFC3339)
+	}
+
+	metrics := make([]interface{}, len(status.CurrentMetrics))
+	for i, metric := range status.CurrentMetrics {
+		cm := map[string]interface{}{
+			"type": string(metric.Type),
+		}
+		switch {
+		case metric.Resource != nil:
+			cm["name"] = string(metric.Resource.Name)
+			if metric.Resource.CurrentAverageUtilization != nil {
+				cm["current_average_utilization"] = int(*metric.Resource.CurrentAverageUtilization)
+			}
+			cm["current_average_value"] = metric.Resource.CurrentAverageValue.String()
+		case metric.Pods != nil:
+			cm["metric_name"] = metric.Pods.MetricName
+			cm["current_average_value"] = metric.Pods.CurrentAverageValue.String()
+		case metric.Object != nil:
+			cm["metric_name"] = metric.Object.MetricName
+			cm["current_value"] = metric.Object.CurrentValue.String()
+		case metric.External != nil:
+			cm["metric_name"] = metric.External.MetricName
+			cm["current_value"] = metric.External.CurrentValue.String()
+			if metric.External.CurrentAverageValue != nil {
+				cm["current_average_value"] = metric.External.CurrentAverageValue.String()
+			}
+		}
+		metrics[i] = cm
+	}
+	m["current_metric"] = metrics
+
+	conditions := make([]interface{}, len(status.Conditions))
+	for i, c := range status.Conditions {
+		conditions[i] = map[string]interface{}{
+			"type":                 string(c.Type),
+			"status":               string(c.Status),
+			"reason":               c.Reason,
+			"message":              c.Message,
+			"last_transition_time": c.LastTransitionTime.Format(time.RFC3339),
+		}
+	}
+	m["condition"] = conditions
+
+	return []interface{}{m}
+}
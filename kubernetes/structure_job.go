@@ -1,8 +1,12 @@
 package kubernetes
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/api/core/v1"
 )
 
 func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData) ([]interface{}, error) {
@@ -52,6 +56,50 @@ func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData) ([]interface{},
 	return []interface{}{att}, nil
 }
 
+func flattenJobStatus(in batchv1.JobStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["active"] = int(in.Active)
+	att["failed"] = int(in.Failed)
+	att["succeeded"] = int(in.Succeeded)
+	if in.StartTime != nil {
+		att["start_time"] = in.StartTime.Format(time.RFC3339)
+	}
+	if in.CompletionTime != nil {
+		att["completion_time"] = in.CompletionTime.Format(time.RFC3339)
+	}
+
+	conditions := make([]interface{}, len(in.Conditions), len(in.Conditions))
+	for i, c := range in.Conditions {
+		conditions[i] = flattenWorkloadCondition(string(c.Type), c.Status, c.Reason, c.Message, c.LastTransitionTime)
+	}
+	att["condition"] = conditions
+
+	return []interface{}{att}
+}
+
+// failedJobPodMessages describes the containers of the given job pods that
+// terminated unsuccessfully, preferring their termination message.
+func failedJobPodMessages(pods []v1.Pod, limit int) []string {
+	out := make([]string, 0)
+	for _, p := range pods {
+		for _, cs := range p.Status.ContainerStatuses {
+			if len(out) >= limit {
+				return out
+			}
+			t := cs.State.Terminated
+			if t == nil || t.ExitCode == 0 {
+				continue
+			}
+			msg := t.Message
+			if msg == "" {
+				msg = t.Reason
+			}
+			out = append(out, fmt.Sprintf("%s (%s): exit code %d: %s", p.Name, cs.Name, t.ExitCode, msg))
+		}
+	}
+	return out
+}
+
 func expandJobSpec(j []interface{}) (batchv1.JobSpec, error) {
 	obj := batchv1.JobSpec{}
 
@@ -0,0 +1,58 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFailedJobPodMessages(t *testing.T) {
+	terminated := func(name string, exitCode int32, reason, message string) v1.ContainerStatus {
+		return v1.ContainerStatus{
+			Name: name,
+			State: v1.ContainerState{
+				Terminated: &v1.ContainerStateTerminated{
+					ExitCode: exitCode,
+					Reason:   reason,
+					Message:  message,
+				},
+			},
+		}
+	}
+	pods := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "migrate-abcde"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					terminated("migrate", 1, "Error", "relation \"users\" does not exist"),
+					terminated("sidecar", 0, "Completed", ""),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "migrate-fghij"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "migrate", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+					terminated("init", 137, "OOMKilled", ""),
+				},
+			},
+		},
+	}
+
+	expected := []string{
+		`migrate-abcde (migrate): exit code 1: relation "users" does not exist`,
+		"migrate-fghij (init): exit code 137: OOMKilled",
+	}
+	out := failedJobPodMessages(pods, 3)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, out)
+	}
+
+	out = failedJobPodMessages(pods, 1)
+	if !reflect.DeepEqual(out, expected[:1]) {
+		t.Fatalf("expected %#v, got %#v", expected[:1], out)
+	}
+}
@@ -1,8 +1,12 @@
 package kubernetes
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // Flatteners
@@ -65,3 +69,59 @@ func expandLabelSelectorRequirement(l []interface{}) []metav1.LabelSelectorRequi
 	}
 	return obj
 }
+
+// Validators
+
+// validateLabelSelectorMatchesLabels checks that the given selector selects
+// objects carrying the given labels. An empty selector is not checked.
+func validateLabelSelectorMatchesLabels(in *metav1.LabelSelector, l map[string]string) error {
+	if len(in.MatchLabels) == 0 && len(in.MatchExpressions) == 0 {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(in)
+	if err != nil {
+		return fmt.Errorf("invalid label selector: %s", err)
+	}
+	if !selector.Matches(labels.Set(l)) {
+		return fmt.Errorf("selector %q does not match template labels %v", selector.String(), l)
+	}
+	return nil
+}
+
+// templateSelectorCustomizeDiff returns a CustomizeDiffFunc checking that the
+// selector found at selectorKey matches the pod template labels found at
+// templateLabelsKey, so a mismatch is reported at plan time rather than by the
+// API during apply. The check is skipped while labels are not yet known.
+func templateSelectorCustomizeDiff(selectorKey, templateLabelsKey string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		// Maps are read through their count, which is only known once every
+		// value is: reading a computed map itself panics in the diff reader.
+		if _, ok := diff.GetOk(templateLabelsKey + ".%"); !ok {
+			return nil
+		}
+		templateLabels := expandStringMap(diff.Get(templateLabelsKey).(map[string]interface{}))
+
+		// Requirements are ANDed, so leaving out match_labels that are not
+		// known yet can only hide a mismatch, never report a false one.
+		selector := &metav1.LabelSelector{}
+		if _, ok := diff.GetOk(selectorKey + ".0.match_labels.%"); ok {
+			selector.MatchLabels = expandStringMap(diff.Get(selectorKey + ".0.match_labels").(map[string]interface{}))
+		}
+		if v, ok := diff.GetOk(selectorKey + ".0.match_expressions"); ok {
+			selector.MatchExpressions = expandLabelSelectorRequirement(v.([]interface{}))
+		}
+		for _, r := range selector.MatchExpressions {
+			for _, v := range r.Values {
+				if v == config.UnknownVariableValue {
+					return nil
+				}
+			}
+		}
+
+		err := validateLabelSelectorMatchesLabels(selector, templateLabels)
+		if err != nil {
+			return fmt.Errorf("%s: %s", selectorKey, err)
+		}
+		return nil
+	}
+}
@@ -1,6 +1,9 @@
 package kubernetes
 
 import (
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"reflect"
@@ -34,3 +37,172 @@ func TestFlattenLabelSelector(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateLabelSelectorMatchesLabels(t *testing.T) {
+	templateLabels := map[string]string{"app": "web", "tier": "frontend"}
+	cases := []struct {
+		Selector  *metav1.LabelSelector
+		ExpectErr bool
+	}{
+		{&metav1.LabelSelector{}, false},
+		{&metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}, false},
+		{&metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}, true},
+		{
+			&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"frontend", "backend"}},
+					{Key: "canary", Operator: metav1.LabelSelectorOpDoesNotExist},
+				},
+			},
+			false,
+		},
+		{
+			&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tier", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"frontend"}},
+				},
+			},
+			true,
+		},
+		{
+			&metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "release", Operator: metav1.LabelSelectorOpExists},
+				},
+			},
+			true,
+		},
+	}
+
+	for i, tc := range cases {
+		err := validateLabelSelectorMatchesLabels(tc.Selector, templateLabels)
+		if tc.ExpectErr && err == nil {
+			t.Fatalf("%d: expected an error, got none", i)
+		}
+		if !tc.ExpectErr && err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+	}
+}
+
+func TestTemplateSelectorCustomizeDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"selector": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: labelSelectorFields(false),
+							},
+						},
+						"template": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metadata": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"labels": {
+													Type:     schema.TypeMap,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: templateSelectorCustomizeDiff("spec.0.selector", "spec.0.template.0.metadata.0.labels"),
+	}
+	spec := func(selector map[string]interface{}, labels interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"spec": []interface{}{
+				map[string]interface{}{
+					"selector": []interface{}{selector},
+					"template": []interface{}{
+						map[string]interface{}{
+							"metadata": []interface{}{
+								map[string]interface{}{"labels": labels},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name      string
+		Raw       map[string]interface{}
+		ExpectErr bool
+	}{
+		{
+			Name: "matching",
+			Raw: spec(map[string]interface{}{"match_labels": map[string]interface{}{"app": "web"}},
+				map[string]interface{}{"app": "web"}),
+		},
+		{
+			Name: "mismatch",
+			Raw: spec(map[string]interface{}{"match_labels": map[string]interface{}{"app": "db"}},
+				map[string]interface{}{"app": "web"}),
+			ExpectErr: true,
+		},
+		{
+			Name: "unknown label value",
+			Raw: spec(map[string]interface{}{"match_labels": map[string]interface{}{"app": "db"}},
+				map[string]interface{}{"app": config.UnknownVariableValue}),
+		},
+		{
+			Name: "computed labels",
+			Raw: spec(map[string]interface{}{"match_labels": map[string]interface{}{"app": "web"}},
+				config.UnknownVariableValue),
+		},
+		{
+			Name: "computed match labels",
+			Raw: spec(map[string]interface{}{"match_labels": config.UnknownVariableValue},
+				map[string]interface{}{"app": "web"}),
+		},
+		{
+			Name: "computed match labels with mismatching expression",
+			Raw: spec(map[string]interface{}{
+				"match_labels": config.UnknownVariableValue,
+				"match_expressions": []interface{}{
+					map[string]interface{}{"key": "app", "operator": "In", "values": []interface{}{"db"}},
+				},
+			}, map[string]interface{}{"app": "web"}),
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, err := config.NewRawConfig(tc.Raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = r.Diff(nil, terraform.NewResourceConfig(c), nil)
+			if tc.ExpectErr && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !tc.ExpectErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
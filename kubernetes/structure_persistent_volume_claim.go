@@ -20,6 +20,9 @@ func flattenPersistentVolumeClaimSpec(in v1.PersistentVolumeClaimSpec) []interfa
 	if in.StorageClassName != nil {
 		att["storage_class_name"] = *in.StorageClassName
 	}
+	if in.VolumeMode != nil {
+		att["volume_mode"] = string(*in.VolumeMode)
+	}
 	return []interface{}{att}
 }
 
@@ -58,6 +61,10 @@ func expandPersistentVolumeClaimSpec(l []interface{}) (v1.PersistentVolumeClaimS
 	if v, ok := in["storage_class_name"].(string); ok && v != "" {
 		obj.StorageClassName = ptrToString(v)
 	}
+	if v, ok := in["volume_mode"].(string); ok && v != "" {
+		mode := v1.PersistentVolumeMode(v)
+		obj.VolumeMode = &mode
+	}
 	return obj, nil
 }
 
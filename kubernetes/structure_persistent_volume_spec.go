@@ -251,6 +251,40 @@ func flattenSecretReference(in *v1.SecretReference) []interface{} {
 	if in.Name != "" {
 		att["name"] = in.Name
 	}
+	if in.Namespace != "" {
+		att["namespace"] = in.Namespace
+	}
+	return []interface{}{att}
+}
+
+func flattenCSIPersistentVolumeSource(in *v1.CSIPersistentVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	att["driver"] = in.Driver
+	att["volume_handle"] = in.VolumeHandle
+	if in.ReadOnly != false {
+		att["read_only"] = in.ReadOnly
+	}
+	if in.FSType != "" {
+		att["fs_type"] = in.FSType
+	}
+	if len(in.VolumeAttributes) > 0 {
+		att["volume_attributes"] = in.VolumeAttributes
+	}
+	if in.ControllerPublishSecretRef != nil {
+		att["controller_publish_secret_ref"] = flattenSecretReference(in.ControllerPublishSecretRef)
+	}
+	if in.NodeStageSecretRef != nil {
+		att["node_stage_secret_ref"] = flattenSecretReference(in.NodeStageSecretRef)
+	}
+	if in.NodePublishSecretRef != nil {
+		att["node_publish_secret_ref"] = flattenSecretReference(in.NodePublishSecretRef)
+	}
+	return []interface{}{att}
+}
+
+func flattenLocalVolumeSource(in *v1.LocalVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	att["path"] = in.Path
 	return []interface{}{att}
 }
 
@@ -314,6 +348,12 @@ func flattenPersistentVolumeSource(in v1.PersistentVolumeSource) []interface{} {
 	if in.PhotonPersistentDisk != nil {
 		att["photon_persistent_disk"] = flattenPhotonPersistentDiskVolumeSource(in.PhotonPersistentDisk)
 	}
+	if in.CSI != nil {
+		att["csi"] = flattenCSIPersistentVolumeSource(in.CSI)
+	}
+	if in.Local != nil {
+		att["local"] = flattenLocalVolumeSource(in.Local)
+	}
 	return []interface{}{att}
 }
 
@@ -336,6 +376,38 @@ func flattenPersistentVolumeSpec(in v1.PersistentVolumeSpec) []interface{} {
 	if in.StorageClassName != "" {
 		att["storage_class_name"] = in.StorageClassName
 	}
+	if in.VolumeMode != nil {
+		att["volume_mode"] = string(*in.VolumeMode)
+	}
+	if in.NodeAffinity != nil {
+		att["node_affinity"] = flattenVolumeNodeAffinity(in.NodeAffinity)
+	}
+	if in.ClaimRef != nil {
+		att["claim_ref"] = flattenPersistentVolumeClaimRef(in.ClaimRef)
+	}
+	return []interface{}{att}
+}
+
+func flattenVolumeNodeAffinity(in *v1.VolumeNodeAffinity) []interface{} {
+	att := make(map[string]interface{})
+	if in.Required != nil {
+		att["required"] = flattenNodeSelector(in.Required)
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeClaimRef(in *v1.ObjectReference) []interface{} {
+	att := make(map[string]interface{})
+	att["name"] = in.Name
+	if in.Namespace != "" {
+		att["namespace"] = in.Namespace
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeStatus(in v1.PersistentVolumeStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["phase"] = string(in.Phase)
 	return []interface{}{att}
 }
 
@@ -749,9 +821,52 @@ func expandSecretReference(l []interface{}) *v1.SecretReference {
 	if v, ok := in["name"].(string); ok {
 		obj.Name = v
 	}
+	if v, ok := in["namespace"].(string); ok {
+		obj.Namespace = v
+	}
+	return obj
+}
+
+func expandCSIPersistentVolumeSource(l []interface{}) *v1.CSIPersistentVolumeSource {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.CSIPersistentVolumeSource{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.CSIPersistentVolumeSource{
+		Driver:       in["driver"].(string),
+		VolumeHandle: in["volume_handle"].(string),
+	}
+	if v, ok := in["read_only"].(bool); ok {
+		obj.ReadOnly = v
+	}
+	if v, ok := in["fs_type"].(string); ok {
+		obj.FSType = v
+	}
+	if v, ok := in["volume_attributes"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.VolumeAttributes = expandStringMap(v)
+	}
+	if v, ok := in["controller_publish_secret_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.ControllerPublishSecretRef = expandSecretReference(v)
+	}
+	if v, ok := in["node_stage_secret_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.NodeStageSecretRef = expandSecretReference(v)
+	}
+	if v, ok := in["node_publish_secret_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.NodePublishSecretRef = expandSecretReference(v)
+	}
 	return obj
 }
 
+func expandLocalVolumeSource(l []interface{}) *v1.LocalVolumeSource {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.LocalVolumeSource{}
+	}
+	in := l[0].(map[string]interface{})
+	return &v1.LocalVolumeSource{
+		Path: in["path"].(string),
+	}
+}
+
 func expandNFSVolumeSource(l []interface{}) *v1.NFSVolumeSource {
 	if len(l) == 0 || l[0] == nil {
 		return &v1.NFSVolumeSource{}
@@ -821,6 +936,12 @@ func expandPersistentVolumeSource(l []interface{}) v1.PersistentVolumeSource {
 	if v, ok := in["photon_persistent_disk"].([]interface{}); ok && len(v) > 0 {
 		obj.PhotonPersistentDisk = expandPhotonPersistentDiskVolumeSource(v)
 	}
+	if v, ok := in["csi"].([]interface{}); ok && len(v) > 0 {
+		obj.CSI = expandCSIPersistentVolumeSource(v)
+	}
+	if v, ok := in["local"].([]interface{}); ok && len(v) > 0 {
+		obj.Local = expandLocalVolumeSource(v)
+	}
 	return obj
 }
 
@@ -852,9 +973,47 @@ func expandPersistentVolumeSpec(l []interface{}) (v1.PersistentVolumeSpec, error
 	if v, ok := in["storage_class_name"].(string); ok {
 		obj.StorageClassName = v
 	}
+	if v, ok := in["volume_mode"].(string); ok && v != "" {
+		mode := v1.PersistentVolumeMode(v)
+		obj.VolumeMode = &mode
+	}
+	if v, ok := in["node_affinity"].([]interface{}); ok && len(v) > 0 {
+		obj.NodeAffinity = expandVolumeNodeAffinity(v)
+	}
+	if v, ok := in["claim_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.ClaimRef = expandPersistentVolumeClaimRef(v)
+	}
 	return obj, nil
 }
 
+func expandVolumeNodeAffinity(l []interface{}) *v1.VolumeNodeAffinity {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.VolumeNodeAffinity{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.VolumeNodeAffinity{}
+	if v, ok := in["required"].([]interface{}); ok && len(v) > 0 {
+		obj.Required = expandNodeSelector(v)
+	}
+	return obj
+}
+
+func expandPersistentVolumeClaimRef(l []interface{}) *v1.ObjectReference {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.ObjectReference{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.ObjectReference{
+		Kind:       "PersistentVolumeClaim",
+		APIVersion: "v1",
+		Name:       in["name"].(string),
+	}
+	if v, ok := in["namespace"].(string); ok {
+		obj.Namespace = v
+	}
+	return obj
+}
+
 func expandPhotonPersistentDiskVolumeSource(l []interface{}) *v1.PhotonPersistentDiskVolumeSource {
 	if len(l) == 0 || l[0] == nil {
 		return &v1.PhotonPersistentDiskVolumeSource{}
@@ -1002,6 +1161,19 @@ func patchPersistentVolumeSpec(pathPrefix, prefix string, d *schema.ResourceData
 			Value: v1.PersistentVolumeReclaimPolicy(v),
 		})
 	}
+	if d.HasChange(prefix + "claim_ref") {
+		v := d.Get(prefix + "claim_ref").([]interface{})
+		if len(v) > 0 {
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/claimRef",
+				Value: expandPersistentVolumeClaimRef(v),
+			})
+		} else {
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/claimRef",
+			})
+		}
+	}
 	if d.HasChange(prefix + "storage_class_name") {
 		o, n := d.GetChange(prefix + "storage_class_name")
 		if v, ok := o.(string); ok && len(v) > 0 {
@@ -1397,5 +1569,49 @@ func patchPersistentVolumeSource(pathPrefix, prefix string, d *schema.ResourceDa
 		}
 	}
 
+	if d.HasChange(prefix + "csi") {
+		oldIn, newIn := d.GetChange(prefix + "csi")
+		oldV, oldOk := oldIn.([]interface{})
+		newV, newOk := newIn.([]interface{})
+
+		if newOk && len(newV) > 0 {
+			if oldOk && len(oldV) > 0 {
+				ops = append(ops, &ReplaceOperation{
+					Path:  pathPrefix + "/csi",
+					Value: expandCSIPersistentVolumeSource(newV),
+				})
+			} else {
+				ops = append(ops, &AddOperation{
+					Path:  pathPrefix + "/csi",
+					Value: expandCSIPersistentVolumeSource(newV),
+				})
+			}
+		} else if oldOk && len(oldV) > 0 {
+			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/csi"})
+		}
+	}
+
+	if d.HasChange(prefix + "local") {
+		oldIn, newIn := d.GetChange(prefix + "local")
+		oldV, oldOk := oldIn.([]interface{})
+		newV, newOk := newIn.([]interface{})
+
+		if newOk && len(newV) > 0 {
+			if oldOk && len(oldV) > 0 {
+				ops = append(ops, &ReplaceOperation{
+					Path:  pathPrefix + "/local",
+					Value: expandLocalVolumeSource(newV),
+				})
+			} else {
+				ops = append(ops, &AddOperation{
+					Path:  pathPrefix + "/local",
+					Value: expandLocalVolumeSource(newV),
+				})
+			}
+		} else if oldOk && len(oldV) > 0 {
+			ops = append(ops, &RemoveOperation{Path: pathPrefix + "/local"})
+		}
+	}
+
 	return ops
 }
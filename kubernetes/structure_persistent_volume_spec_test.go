@@ -0,0 +1,106 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestPersistentVolumeSpecNodeAffinityAndClaimRefRoundTrip(t *testing.T) {
+	in := v1.PersistentVolumeSpec{
+		AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+		Capacity: v1.ResourceList{
+			v1.ResourceStorage: resource.MustParse("10Gi"),
+		},
+		PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimRetain,
+		PersistentVolumeSource: v1.PersistentVolumeSource{
+			Local: &v1.LocalVolumeSource{Path: "/mnt/disks/ssd1"},
+		},
+		NodeAffinity: &v1.VolumeNodeAffinity{
+			Required: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{
+						MatchExpressions: []v1.NodeSelectorRequirement{
+							{Key: "kubernetes.io/hostname", Operator: v1.NodeSelectorOpIn, Values: []string{"example-node"}},
+						},
+					},
+				},
+			},
+		},
+		ClaimRef: &v1.ObjectReference{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+			Name:       "data",
+			Namespace:  "apps",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesPersistentVolume().Schema, map[string]interface{}{})
+	if err := d.Set("spec", flattenPersistentVolumeSpec(in)); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out.NodeAffinity, in.NodeAffinity) {
+		t.Errorf("node affinity mismatch:\nexpected: %#v\ngot: %#v", in.NodeAffinity, out.NodeAffinity)
+	}
+	if !reflect.DeepEqual(out.ClaimRef, in.ClaimRef) {
+		t.Errorf("claim ref mismatch:\nexpected: %#v\ngot: %#v", in.ClaimRef, out.ClaimRef)
+	}
+}
+
+func TestPersistentVolumeNodeAffinityIsForceNew(t *testing.T) {
+	elem := func(s *schema.Schema) map[string]*schema.Schema {
+		return s.Elem.(*schema.Resource).Schema
+	}
+	spec := elem(resourceKubernetesPersistentVolume().Schema["spec"])
+	term := elem(elem(elem(spec["node_affinity"])["required"])["node_selector_term"])
+	expr := elem(term["match_expressions"])
+	for _, k := range []string{"key", "operator", "values"} {
+		if !expr[k].ForceNew {
+			t.Errorf("expected node_affinity match_expressions %s to be ForceNew", k)
+		}
+	}
+}
+
+func TestPersistentVolumeSpecSecretRefNamespaceRoundTrip(t *testing.T) {
+	secretRef := &v1.SecretReference{Name: "ceph-secret", Namespace: "kube-system"}
+	sources := []v1.PersistentVolumeSource{
+		{CephFS: &v1.CephFSPersistentVolumeSource{Monitors: []string{"10.16.154.78:6789"}, SecretRef: secretRef}},
+		{RBD: &v1.RBDPersistentVolumeSource{CephMonitors: []string{"10.16.154.78:6789"}, RBDImage: "foo", SecretRef: secretRef}},
+	}
+
+	for _, source := range sources {
+		in := v1.PersistentVolumeSpec{
+			AccessModes:            []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Capacity:               v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+			PersistentVolumeSource: source,
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceKubernetesPersistentVolume().Schema, map[string]interface{}{})
+		if err := d.Set("spec", flattenPersistentVolumeSpec(in)); err != nil {
+			t.Fatal(err)
+		}
+
+		out, err := expandPersistentVolumeSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got *v1.SecretReference
+		if out.CephFS != nil {
+			got = out.CephFS.SecretRef
+		}
+		if out.RBD != nil {
+			got = out.RBD.SecretRef
+		}
+		if !reflect.DeepEqual(got, secretRef) {
+			t.Errorf("secret ref mismatch:\nexpected: %#v\ngot: %#v", secretRef, got)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package kubernetes
+
+import (
+	api "k8s.io/api/settings/v1alpha1"
+)
+
+// Expanders
+
+func expandPodPresetSpec(in []interface{}) (api.PodPresetSpec, error) {
+	spec := api.PodPresetSpec{}
+	if len(in) == 0 || in[0] == nil {
+		return spec, nil
+	}
+	m := in[0].(map[string]interface{})
+
+	if v, ok := m["selector"].([]interface{}); ok && len(v) > 0 {
+		spec.Selector = *expandLabelSelector(v)
+	}
+	if v, ok := m["env"].([]interface{}); ok && len(v) > 0 {
+		env, err := expandContainerEnv(v)
+		if err != nil {
+			return spec, err
+		}
+		spec.Env = env
+	}
+	if v, ok := m["env_from"].([]interface{}); ok && len(v) > 0 {
+		envFrom, err := expandContainerEnvFrom(v)
+		if err != nil {
+			return spec, err
+		}
+		spec.EnvFrom = envFrom
+	}
+	if v, ok := m["volume"].([]interface{}); ok && len(v) > 0 {
+		volumes, err := expandVolumes(v)
+		if err != nil {
+			return spec, err
+		}
+		spec.Volumes = volumes
+	}
+	if v, ok := m["volume_mount"].([]interface{}); ok && len(v) > 0 {
+		mounts, err := expandContainerVolumeMounts(v)
+		if err != nil {
+			return spec, err
+		}
+		spec.VolumeMounts = mounts
+	}
+
+	return spec, nil
+}
+
+// Flatteners
+
+func flattenPodPresetSpec(in api.PodPresetSpec) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["selector"] = flattenLabelSelector(&in.Selector)
+	if len(in.Env) > 0 {
+		att["env"] = flattenContainerEnvs(in.Env)
+	}
+	if len(in.EnvFrom) > 0 {
+		att["env_from"] = flattenContainerEnvFroms(in.EnvFrom)
+	}
+	if len(in.Volumes) > 0 {
+		v, err := flattenVolumes(in.Volumes)
+		if err != nil {
+			return nil, err
+		}
+		att["volume"] = v
+	}
+	if len(in.VolumeMounts) > 0 {
+		v, err := flattenContainerVolumeMounts(in.VolumeMounts)
+		if err != nil {
+			return nil, err
+		}
+		att["volume_mount"] = v
+	}
+
+	return []interface{}{att}, nil
+}
@@ -0,0 +1,278 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	v1 "k8s.io/api/core/v1"
+	api "k8s.io/api/policy/v1beta1"
+)
+
+// Expanders
+
+func expandPodSecurityPolicySpec(d *schema.ResourceData) api.PodSecurityPolicySpec {
+	spec := api.PodSecurityPolicySpec{}
+	in := d.Get("spec").([]interface{})
+	if len(in) == 0 || in[0] == nil {
+		return spec
+	}
+	m := in[0].(map[string]interface{})
+
+	if v, ok := m["allow_privilege_escalation"].(bool); ok {
+		spec.AllowPrivilegeEscalation = ptrToBool(v)
+	}
+	if v, ok := m["allowed_capabilities"].([]interface{}); ok && len(v) > 0 {
+		spec.AllowedCapabilities = expandCapabilitySlice(v)
+	}
+	if v, ok := m["allowed_flex_volumes"].([]interface{}); ok && len(v) > 0 {
+		spec.AllowedFlexVolumes = expandAllowedFlexVolumes(v)
+	}
+	if v, ok := m["allowed_host_paths"].([]interface{}); ok && len(v) > 0 {
+		spec.AllowedHostPaths = expandAllowedHostPaths(v)
+	}
+	if v, ok := m["allowed_unsafe_sysctls"].([]interface{}); ok && len(v) > 0 {
+		spec.AllowedUnsafeSysctls = expandStringSlice(v)
+	}
+	if v, ok := m["default_add_capabilities"].([]interface{}); ok && len(v) > 0 {
+		spec.DefaultAddCapabilities = expandCapabilitySlice(v)
+	}
+	// An explicit false must reach the API, so tell it apart from unset
+	if v, ok := d.GetOkExists("spec.0.default_allow_privilege_escalation"); ok {
+		spec.DefaultAllowPrivilegeEscalation = ptrToBool(v.(bool))
+	}
+	if v, ok := m["forbidden_sysctls"].([]interface{}); ok && len(v) > 0 {
+		spec.ForbiddenSysctls = expandStringSlice(v)
+	}
+	if v, ok := m["fs_group"].([]interface{}); ok && len(v) > 0 {
+		rule, ranges := expandPodSecurityPolicyIDRangeStrategy(v)
+		spec.FSGroup = api.FSGroupStrategyOptions{
+			Rule:   api.FSGroupStrategyType(rule),
+			Ranges: ranges,
+		}
+	}
+	if v, ok := m["host_ipc"].(bool); ok {
+		spec.HostIPC = v
+	}
+	if v, ok := m["host_network"].(bool); ok {
+		spec.HostNetwork = v
+	}
+	if v, ok := m["host_pid"].(bool); ok {
+		spec.HostPID = v
+	}
+	if v, ok := m["host_ports"].([]interface{}); ok && len(v) > 0 {
+		spec.HostPorts = expandHostPortRanges(v)
+	}
+	if v, ok := m["privileged"].(bool); ok {
+		spec.Privileged = v
+	}
+	if v, ok := m["read_only_root_filesystem"].(bool); ok {
+		spec.ReadOnlyRootFilesystem = v
+	}
+	if v, ok := m["required_drop_capabilities"].([]interface{}); ok && len(v) > 0 {
+		spec.RequiredDropCapabilities = expandCapabilitySlice(v)
+	}
+	if v, ok := m["run_as_user"].([]interface{}); ok && len(v) > 0 {
+		rule, ranges := expandPodSecurityPolicyIDRangeStrategy(v)
+		spec.RunAsUser = api.RunAsUserStrategyOptions{
+			Rule:   api.RunAsUserStrategy(rule),
+			Ranges: ranges,
+		}
+	}
+	if v, ok := m["se_linux"].([]interface{}); ok && len(v) > 0 {
+		spec.SELinux = expandSELinuxStrategyOptions(v)
+	}
+	if v, ok := m["supplemental_groups"].([]interface{}); ok && len(v) > 0 {
+		rule, ranges := expandPodSecurityPolicyIDRangeStrategy(v)
+		spec.SupplementalGroups = api.SupplementalGroupsStrategyOptions{
+			Rule:   api.SupplementalGroupsStrategyType(rule),
+			Ranges: ranges,
+		}
+	}
+	if v, ok := m["volumes"].([]interface{}); ok && len(v) > 0 {
+		volumes := make([]api.FSType, len(v))
+		for i, fs := range v {
+			volumes[i] = api.FSType(fs.(string))
+		}
+		spec.Volumes = volumes
+	}
+
+	return spec
+}
+
+func expandAllowedFlexVolumes(in []interface{}) []api.AllowedFlexVolume {
+	out := make([]api.AllowedFlexVolume, len(in))
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		if driver, ok := m["driver"].(string); ok {
+			out[i].Driver = driver
+		}
+	}
+	return out
+}
+
+func expandAllowedHostPaths(in []interface{}) []api.AllowedHostPath {
+	out := make([]api.AllowedHostPath, len(in))
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		if prefix, ok := m["path_prefix"].(string); ok {
+			out[i].PathPrefix = prefix
+		}
+		if readOnly, ok := m["read_only"].(bool); ok {
+			out[i].ReadOnly = readOnly
+		}
+	}
+	return out
+}
+
+func expandHostPortRanges(in []interface{}) []api.HostPortRange {
+	out := make([]api.HostPortRange, len(in))
+	for i, v := range in {
+		m := v.(map[string]interface{})
+		if min, ok := m["min"].(int); ok {
+			out[i].Min = int32(min)
+		}
+		if max, ok := m["max"].(int); ok {
+			out[i].Max = int32(max)
+		}
+	}
+	return out
+}
+
+func expandPodSecurityPolicyIDRangeStrategy(in []interface{}) (string, []api.IDRange) {
+	if len(in) == 0 || in[0] == nil {
+		return "", nil
+	}
+	m := in[0].(map[string]interface{})
+
+	rule := ""
+	if v, ok := m["rule"].(string); ok {
+		rule = v
+	}
+
+	var ranges []api.IDRange
+	if v, ok := m["range"].([]interface{}); ok && len(v) > 0 {
+		ranges = make([]api.IDRange, len(v))
+		for i, r := range v {
+			rm := r.(map[string]interface{})
+			if min, ok := rm["min"].(int); ok {
+				ranges[i].Min = int64(min)
+			}
+			if max, ok := rm["max"].(int); ok {
+				ranges[i].Max = int64(max)
+			}
+		}
+	}
+
+	return rule, ranges
+}
+
+func expandSELinuxStrategyOptions(in []interface{}) api.SELinuxStrategyOptions {
+	obj := api.SELinuxStrategyOptions{}
+	if len(in) == 0 || in[0] == nil {
+		return obj
+	}
+	m := in[0].(map[string]interface{})
+
+	if v, ok := m["rule"].(string); ok {
+		obj.Rule = api.SELinuxStrategy(v)
+	}
+	if v, ok := m["se_linux_options"].([]interface{}); ok && len(v) > 0 {
+		obj.SELinuxOptions = expandSeLinuxOptions(v)
+	}
+
+	return obj
+}
+
+// Flatteners
+
+func flattenPodSecurityPolicySpec(in api.PodSecurityPolicySpec) []interface{} {
+	m := make(map[string]interface{})
+
+	if in.AllowPrivilegeEscalation != nil {
+		m["allow_privilege_escalation"] = *in.AllowPrivilegeEscalation
+	}
+	m["allowed_capabilities"] = flattenCapability(in.AllowedCapabilities)
+	m["allowed_flex_volumes"] = flattenAllowedFlexVolumes(in.AllowedFlexVolumes)
+	m["allowed_host_paths"] = flattenAllowedHostPaths(in.AllowedHostPaths)
+	m["allowed_unsafe_sysctls"] = in.AllowedUnsafeSysctls
+	m["default_add_capabilities"] = flattenCapability(in.DefaultAddCapabilities)
+	if in.DefaultAllowPrivilegeEscalation != nil {
+		m["default_allow_privilege_escalation"] = *in.DefaultAllowPrivilegeEscalation
+	}
+	m["forbidden_sysctls"] = in.ForbiddenSysctls
+	m["fs_group"] = flattenPodSecurityPolicyIDRangeStrategy(string(in.FSGroup.Rule), in.FSGroup.Ranges)
+	m["host_ipc"] = in.HostIPC
+	m["host_network"] = in.HostNetwork
+	m["host_pid"] = in.HostPID
+	m["host_ports"] = flattenHostPortRanges(in.HostPorts)
+	m["privileged"] = in.Privileged
+	m["read_only_root_filesystem"] = in.ReadOnlyRootFilesystem
+	m["required_drop_capabilities"] = flattenCapability(in.RequiredDropCapabilities)
+	m["run_as_user"] = flattenPodSecurityPolicyIDRangeStrategy(string(in.RunAsUser.Rule), in.RunAsUser.Ranges)
+	m["se_linux"] = flattenSELinuxStrategyOptions(in.SELinux)
+	m["supplemental_groups"] = flattenPodSecurityPolicyIDRangeStrategy(string(in.SupplementalGroups.Rule), in.SupplementalGroups.Ranges)
+
+	volumes := make([]string, len(in.Volumes))
+	for i, v := range in.Volumes {
+		volumes[i] = string(v)
+	}
+	m["volumes"] = volumes
+
+	return []interface{}{m}
+}
+
+func flattenAllowedFlexVolumes(in []api.AllowedFlexVolume) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"driver": v.Driver,
+		}
+	}
+	return att
+}
+
+func flattenAllowedHostPaths(in []api.AllowedHostPath) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"path_prefix": v.PathPrefix,
+			"read_only":   v.ReadOnly,
+		}
+	}
+	return att
+}
+
+func flattenHostPortRanges(in []api.HostPortRange) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"min": int(v.Min),
+			"max": int(v.Max),
+		}
+	}
+	return att
+}
+
+func flattenPodSecurityPolicyIDRangeStrategy(rule string, in []api.IDRange) []interface{} {
+	ranges := make([]interface{}, len(in))
+	for i, v := range in {
+		ranges[i] = map[string]interface{}{
+			"min": int(v.Min),
+			"max": int(v.Max),
+		}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"rule":  rule,
+			"range": ranges,
+		},
+	}
+}
+
+func flattenSELinuxStrategyOptions(in api.SELinuxStrategyOptions) []interface{} {
+	m := map[string]interface{}{
+		"rule": string(in.Rule),
+	}
+	if in.SELinuxOptions != nil && *in.SELinuxOptions != (v1.SELinuxOptions{}) {
+		m["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
+	}
+	return []interface{}{m}
+}
@@ -0,0 +1,55 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestExpandPodSecurityPolicySpecDefaultAllowPrivilegeEscalation(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Raw      map[string]interface{}
+		Expected *bool
+	}{
+		{
+			Name: "unset",
+			Raw: map[string]interface{}{
+				"spec": []interface{}{map[string]interface{}{"privileged": false}},
+			},
+		},
+		{
+			Name: "false",
+			Raw: map[string]interface{}{
+				"spec": []interface{}{map[string]interface{}{"default_allow_privilege_escalation": false}},
+			},
+			Expected: ptrToBool(false),
+		},
+		{
+			Name: "true",
+			Raw: map[string]interface{}{
+				"spec": []interface{}{map[string]interface{}{"default_allow_privilege_escalation": true}},
+			},
+			Expected: ptrToBool(true),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceKubernetesPodSecurityPolicy().Schema, tc.Raw)
+			out := expandPodSecurityPolicySpec(d).DefaultAllowPrivilegeEscalation
+			if tc.Expected == nil {
+				if out != nil {
+					t.Fatalf("Expected nil, got %v", *out)
+				}
+				return
+			}
+			if out == nil {
+				t.Fatalf("Expected %v, got nil", *tc.Expected)
+			}
+			if *out != *tc.Expected {
+				t.Fatalf("Expected %v, got %v", *tc.Expected, *out)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/mitchellh/copystructure"
@@ -223,6 +224,21 @@ func base64EncodeStringMap(m map[string]interface{}) map[string]interface{} {
 	return result
 }
 
+// flattenWorkloadCondition flattens a status condition of a workload controller,
+// which all share the same shape but are declared as distinct types.
+func flattenWorkloadCondition(conditionType string, status api.ConditionStatus, reason, message string, lastTransition metav1.Time) map[string]interface{} {
+	m := map[string]interface{}{
+		"type":    conditionType,
+		"status":  string(status),
+		"reason":  reason,
+		"message": message,
+	}
+	if !lastTransition.IsZero() {
+		m["last_transition_time"] = lastTransition.Format(time.RFC3339)
+	}
+	return m
+}
+
 func flattenResourceList(l api.ResourceList) map[string]string {
 	m := make(map[string]string)
 	for k, v := range l {
@@ -277,11 +293,50 @@ func flattenResourceQuotaSpec(in api.ResourceQuotaSpec) []interface{} {
 	m := make(map[string]interface{}, 0)
 	m["hard"] = flattenResourceList(in.Hard)
 	m["scopes"] = flattenResourceQuotaScopes(in.Scopes)
+	if in.ScopeSelector != nil {
+		m["scope_selector"] = flattenResourceQuotaScopeSelector(in.ScopeSelector)
+	}
 
 	out[0] = m
 	return out
 }
 
+func flattenResourceQuotaScopeSelector(in *api.ScopeSelector) []interface{} {
+	exprs := make([]interface{}, len(in.MatchExpressions), len(in.MatchExpressions))
+	for i, e := range in.MatchExpressions {
+		exprs[i] = map[string]interface{}{
+			"scope_name": string(e.ScopeName),
+			"operator":   string(e.Operator),
+			"values":     newStringSet(schema.HashString, e.Values),
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"match_expression": exprs,
+	}}
+}
+
+func flattenResourceQuotaStatus(in api.ResourceQuotaStatus) []interface{} {
+	m := make(map[string]interface{})
+	m["hard"] = flattenResourceList(in.Hard)
+	m["used"] = flattenResourceList(in.Used)
+	m["remaining"] = flattenResourceList(remainingResourceQuota(in.Hard, in.Used))
+	return []interface{}{m}
+}
+
+// remainingResourceQuota returns how much of each hard limit is left given
+// the current usage. It is negative for resources used beyond their limit.
+func remainingResourceQuota(hard, used api.ResourceList) api.ResourceList {
+	out := make(api.ResourceList, len(hard))
+	for k, v := range hard {
+		q := v.DeepCopy()
+		if u, ok := used[k]; ok {
+			q.Sub(u)
+		}
+		out[k] = q
+	}
+	return out
+}
+
 func expandResourceQuotaSpec(s []interface{}) (api.ResourceQuotaSpec, error) {
 	out := api.ResourceQuotaSpec{}
 	if len(s) < 1 {
@@ -301,6 +356,10 @@ func expandResourceQuotaSpec(s []interface{}) (api.ResourceQuotaSpec, error) {
 		out.Scopes = expandResourceQuotaScopes(v.(*schema.Set).List())
 	}
 
+	if v, ok := m["scope_selector"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		out.ScopeSelector = expandResourceQuotaScopeSelector(v[0].(map[string]interface{}))
+	}
+
 	return out, nil
 }
 
@@ -312,6 +371,23 @@ func flattenResourceQuotaScopes(in []api.ResourceQuotaScope) *schema.Set {
 	return newStringSet(schema.HashString, out)
 }
 
+func expandResourceQuotaScopeSelector(in map[string]interface{}) *api.ScopeSelector {
+	out := &api.ScopeSelector{}
+	exprs, _ := in["match_expression"].([]interface{})
+	for _, e := range exprs {
+		m := e.(map[string]interface{})
+		req := api.ScopedResourceSelectorRequirement{
+			ScopeName: api.ResourceQuotaScope(m["scope_name"].(string)),
+			Operator:  api.ScopeSelectorOperator(m["operator"].(string)),
+		}
+		if v, ok := m["values"].(*schema.Set); ok && v.Len() > 0 {
+			req.Values = sliceOfString(v.List())
+		}
+		out.MatchExpressions = append(out.MatchExpressions, req)
+	}
+	return out
+}
+
 func expandResourceQuotaScopes(s []interface{}) []api.ResourceQuotaScope {
 	out := make([]api.ResourceQuotaScope, len(s), len(s))
 	for i, scope := range s {
@@ -541,3 +617,16 @@ func expandServiceAccountSecrets(in []interface{}, defaultSecretName string) []a
 
 	return att
 }
+
+// sequenceCustomizeDiff returns a CustomizeDiffFunc running the given
+// functions in order and stopping at the first error.
+func sequenceCustomizeDiff(fns ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		for _, fn := range fns {
+			if err := fn(diff, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -280,11 +280,25 @@ func flattenContainerVolumeMounts(in []v1.VolumeMount) ([]interface{}, error) {
 		if v.SubPath != "" {
 			m["sub_path"] = v.SubPath
 		}
+		if v.MountPropagation != nil {
+			m["mount_propagation"] = string(*v.MountPropagation)
+		}
 		att[i] = m
 	}
 	return att, nil
 }
 
+func flattenContainerVolumeDevices(in []v1.VolumeDevice) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"device_path": v.DevicePath,
+			"name":        v.Name,
+		}
+	}
+	return att
+}
+
 func flattenContainerEnvs(in []v1.EnvVar) []interface{} {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
@@ -347,10 +361,10 @@ func flattenContainerPorts(in []v1.ContainerPort) []interface{} {
 func flattenContainerResourceRequirements(in v1.ResourceRequirements) ([]interface{}, error) {
 	att := make(map[string]interface{})
 	if len(in.Limits) > 0 {
-		att["limits"] = []interface{}{flattenResourceList(in.Limits)}
+		att["limits"] = flattenResourceList(in.Limits)
 	}
 	if len(in.Requests) > 0 {
-		att["requests"] = []interface{}{flattenResourceList(in.Requests)}
+		att["requests"] = flattenResourceList(in.Requests)
 	}
 	return []interface{}{att}, nil
 }
@@ -410,6 +424,9 @@ func flattenContainers(in []v1.Container) ([]interface{}, error) {
 			}
 			c["volume_mount"] = volumeMounts
 		}
+		if len(v.VolumeDevices) > 0 {
+			c["volume_device"] = flattenContainerVolumeDevices(v.VolumeDevices)
+		}
 		att[i] = c
 	}
 	return att, nil
@@ -506,6 +523,9 @@ func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 				return cs, err
 			}
 		}
+		if v, ok := ctr["volume_device"].([]interface{}); ok && len(v) > 0 {
+			cs[i].VolumeDevices = expandContainerVolumeDevices(v)
+		}
 	}
 	return cs, nil
 }
@@ -713,10 +733,28 @@ func expandContainerVolumeMounts(in []interface{}) ([]v1.VolumeMount, error) {
 		if subPath, ok := p["sub_path"]; ok {
 			vmp[i].SubPath = subPath.(string)
 		}
+		if mp, ok := p["mount_propagation"].(string); ok && mp != "" {
+			mode := v1.MountPropagationMode(mp)
+			vmp[i].MountPropagation = &mode
+		}
 	}
 	return vmp, nil
 }
 
+func expandContainerVolumeDevices(in []interface{}) []v1.VolumeDevice {
+	devices := make([]v1.VolumeDevice, len(in))
+	for i, c := range in {
+		p := c.(map[string]interface{})
+		if v, ok := p["device_path"].(string); ok {
+			devices[i].DevicePath = v
+		}
+		if v, ok := p["name"].(string); ok {
+			devices[i].Name = v
+		}
+	}
+	return devices
+}
+
 func expandContainerEnv(in []interface{}) ([]v1.EnvVar, error) {
 	if len(in) == 0 {
 		return []v1.EnvVar{}, nil
@@ -934,29 +972,24 @@ func expandContainerResourceRequirements(l []interface{}) (v1.ResourceRequiremen
 	in := l[0].(map[string]interface{})
 	obj := v1.ResourceRequirements{}
 
-	fn := func(in []interface{}) (v1.ResourceList, error) {
-		for _, c := range in {
-			p := c.(map[string]interface{})
-			if p["cpu"] == "" {
-				delete(p, "cpu")
-			}
-			if p["memory"] == "" {
-				delete(p, "memory")
+	fn := func(in map[string]interface{}) (v1.ResourceList, error) {
+		for k, v := range in {
+			if v == "" {
+				delete(in, k)
 			}
-			return expandMapToResourceList(p)
 		}
-		return nil, nil
+		return expandMapToResourceList(in)
 	}
 
 	var err error
-	if v, ok := in["limits"].([]interface{}); ok && len(v) > 0 {
+	if v, ok := in["limits"].(map[string]interface{}); ok && len(v) > 0 {
 		obj.Limits, err = fn(v)
 		if err != nil {
 			return obj, err
 		}
 	}
 
-	if v, ok := in["requests"].([]interface{}); ok && len(v) > 0 {
+	if v, ok := in["requests"].(map[string]interface{}); ok && len(v) > 0 {
 		obj.Requests, err = fn(v)
 		if err != nil {
 			return obj, err
@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -14,7 +13,9 @@ func flattenDaemonSetSpec(in appsv1.DaemonSetSpec, d *schema.ResourceData) ([]in
 	att := make(map[string]interface{})
 	att["min_ready_seconds"] = in.MinReadySeconds
 
-	att["selector"] = in.Selector.MatchLabels
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
 	att["strategy"] = flattenDaemonSetStrategy(in.UpdateStrategy)
 	// podSpec, err := flattenPodSpec(in.Template.Spec)
 	// if err != nil {
@@ -54,6 +55,26 @@ func flattenDaemonSetStrategyRollingUpdate(in *appsv1.RollingUpdateDaemonSet) []
 	return []interface{}{att}
 }
 
+func flattenDaemonSetStatus(in appsv1.DaemonSetStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["observed_generation"] = int(in.ObservedGeneration)
+	att["current_number_scheduled"] = int(in.CurrentNumberScheduled)
+	att["desired_number_scheduled"] = int(in.DesiredNumberScheduled)
+	att["number_available"] = int(in.NumberAvailable)
+	att["number_misscheduled"] = int(in.NumberMisscheduled)
+	att["number_ready"] = int(in.NumberReady)
+	att["number_unavailable"] = int(in.NumberUnavailable)
+	att["updated_number_scheduled"] = int(in.UpdatedNumberScheduled)
+
+	conditions := make([]interface{}, len(in.Conditions), len(in.Conditions))
+	for i, c := range in.Conditions {
+		conditions[i] = flattenWorkloadCondition(string(c.Type), c.Status, c.Reason, c.Message, c.LastTransitionTime)
+	}
+	att["condition"] = conditions
+
+	return []interface{}{att}
+}
+
 func expandDaemonSetSpec(deployment []interface{}) (appsv1.DaemonSetSpec, error) {
 	obj := appsv1.DaemonSetSpec{}
 	if len(deployment) == 0 || deployment[0] == nil {
@@ -61,10 +82,8 @@ func expandDaemonSetSpec(deployment []interface{}) (appsv1.DaemonSetSpec, error)
 	}
 	in := deployment[0].(map[string]interface{})
 	obj.MinReadySeconds = int32(in["min_ready_seconds"].(int))
-	if v, ok := in["selector"]; ok {
-		obj.Selector = &metav1.LabelSelector{
-			MatchLabels: expandStringMap(v.(map[string]interface{})),
-		}
+	if v, ok := in["selector"].([]interface{}); ok {
+		obj.Selector = expandLabelSelector(v)
 	}
 	obj.UpdateStrategy = expandDaemonSetStrategy(in["strategy"].([]interface{}))
 
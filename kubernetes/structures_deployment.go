@@ -5,10 +5,13 @@ import (
 
 	"github.com/hashicorp/terraform/helper/schema"
 	appsv1 "k8s.io/api/apps/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// deploymentRevisionAnnotation is set by the deployment controller to the
+// revision of the rollout it is currently working on.
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
 func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
@@ -32,7 +35,9 @@ func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]
 		att["revision_history_limit"] = 10
 	}
 
-	att["selector"] = in.Selector.MatchLabels
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
 	att["strategy"] = flattenDeploymentStrategy(in.Strategy)
 
 	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d, "spec.0.template.0.")
@@ -71,6 +76,25 @@ func flattenDeploymentStrategyRollingUpdate(in *appsv1.RollingUpdateDeployment)
 	return []interface{}{att}
 }
 
+func flattenDeploymentStatus(in appsv1.Deployment) []interface{} {
+	att := make(map[string]interface{})
+	att["observed_generation"] = int(in.Status.ObservedGeneration)
+	att["available_replicas"] = int(in.Status.AvailableReplicas)
+	att["ready_replicas"] = int(in.Status.ReadyReplicas)
+	att["replicas"] = int(in.Status.Replicas)
+	att["unavailable_replicas"] = int(in.Status.UnavailableReplicas)
+	att["updated_replicas"] = int(in.Status.UpdatedReplicas)
+	att["revision"] = in.Annotations[deploymentRevisionAnnotation]
+
+	conditions := make([]interface{}, len(in.Status.Conditions), len(in.Status.Conditions))
+	for i, c := range in.Status.Conditions {
+		conditions[i] = flattenWorkloadCondition(string(c.Type), c.Status, c.Reason, c.Message, c.LastTransitionTime)
+	}
+	att["condition"] = conditions
+
+	return []interface{}{att}
+}
+
 func expandDeploymentSpec(deployment []interface{}) (appsv1.DeploymentSpec, error) {
 	obj := appsv1.DeploymentSpec{}
 	if len(deployment) == 0 || deployment[0] == nil {
@@ -91,9 +115,7 @@ func expandDeploymentSpec(deployment []interface{}) (appsv1.DeploymentSpec, erro
 		obj.RevisionHistoryLimit = ptrToInt32(int32(in["revision_history_limit"].(int)))
 	}
 
-	obj.Selector = &metav1.LabelSelector{
-		MatchLabels: expandStringMap(in["selector"].(map[string]interface{})),
-	}
+	obj.Selector = expandLabelSelector(in["selector"].([]interface{}))
 
 	for _, v := range in["template"].([]interface{}) {
 		template := v.(map[string]interface{})
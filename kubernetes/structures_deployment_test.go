@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFlattenDeploymentStatus(t *testing.T) {
+	transition := metav1.NewTime(time.Date(2018, 8, 1, 12, 0, 0, 0, time.UTC))
+	in := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				deploymentRevisionAnnotation: "3",
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration:  4,
+			Replicas:            3,
+			UpdatedReplicas:     2,
+			ReadyReplicas:       2,
+			AvailableReplicas:   2,
+			UnavailableReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{
+				{
+					Type:               appsv1.DeploymentProgressing,
+					Status:             v1.ConditionTrue,
+					Reason:             "ReplicaSetUpdated",
+					Message:            `ReplicaSet "web-5c689d88bb" is progressing.`,
+					LastTransitionTime: transition,
+				},
+				{
+					Type:   appsv1.DeploymentAvailable,
+					Status: v1.ConditionFalse,
+					Reason: "MinimumReplicasUnavailable",
+				},
+			},
+		},
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"observed_generation":  4,
+			"replicas":             3,
+			"updated_replicas":     2,
+			"ready_replicas":       2,
+			"available_replicas":   2,
+			"unavailable_replicas": 1,
+			"revision":             "3",
+			"condition": []interface{}{
+				map[string]interface{}{
+					"type":                 "Progressing",
+					"status":               "True",
+					"reason":               "ReplicaSetUpdated",
+					"message":              `ReplicaSet "web-5c689d88bb" is progressing.`,
+					"last_transition_time": "2018-08-01T12:00:00Z",
+				},
+				map[string]interface{}{
+					"type":    "Available",
+					"status":  "False",
+					"reason":  "MinimumReplicasUnavailable",
+					"message": "",
+				},
+			},
+		},
+	}
+
+	out := flattenDeploymentStatus(in)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, out)
+	}
+}
@@ -0,0 +1,93 @@
+package kubernetes
+
+import (
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	nodeZoneLabel       = "topology.kubernetes.io/zone"
+	nodeRegionLabel     = "topology.kubernetes.io/region"
+	betaNodeZoneLabel   = "failure-domain.beta.kubernetes.io/zone"
+	betaNodeRegionLabel = "failure-domain.beta.kubernetes.io/region"
+)
+
+// Flatteners
+
+func flattenNodes(in []v1.Node) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["name"] = n.Name
+		m["labels"] = n.Labels
+		m["provider_id"] = n.Spec.ProviderID
+		m["unschedulable"] = n.Spec.Unschedulable
+		m["taints"] = flattenNodeTaints(n.Spec.Taints)
+		m["addresses"] = flattenNodeAddresses(n.Status.Addresses)
+		m["allocatable"] = flattenResourceList(n.Status.Allocatable)
+		m["capacity"] = flattenResourceList(n.Status.Capacity)
+		m["conditions"] = flattenNodeConditions(n.Status.Conditions)
+		m["kubelet_version"] = n.Status.NodeInfo.KubeletVersion
+		m["zone"] = nodeTopologyLabel(n.Labels, nodeZoneLabel, betaNodeZoneLabel)
+		m["region"] = nodeTopologyLabel(n.Labels, nodeRegionLabel, betaNodeRegionLabel)
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNodeTaints(in []v1.Taint) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["key"] = n.Key
+		m["value"] = n.Value
+		m["effect"] = string(n.Effect)
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNodeAddresses(in []v1.NodeAddress) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["type"] = string(n.Type)
+		m["address"] = n.Address
+		att[i] = m
+	}
+	return att
+}
+
+func flattenNodeConditions(in []v1.NodeCondition) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["type"] = string(n.Type)
+		m["status"] = string(n.Status)
+		m["reason"] = n.Reason
+		m["message"] = n.Message
+		att[i] = m
+	}
+	return att
+}
+
+// nodeTopologyLabel returns the value of the first label in keys that is set.
+func nodeTopologyLabel(labels map[string]string, keys ...string) string {
+	for _, k := range keys {
+		if v, ok := labels[k]; ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// totalAllocatable sums the allocatable quantity of the named resource over all nodes.
+func totalAllocatable(nodes []v1.Node, name v1.ResourceName) resource.Quantity {
+	total := resource.Quantity{}
+	for _, n := range nodes {
+		if q, ok := n.Status.Allocatable[name]; ok {
+			total.Add(q)
+		}
+	}
+	return total
+}
@@ -0,0 +1,95 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFlattenNodes(t *testing.T) {
+	nodes := []v1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-1",
+				Labels: map[string]string{
+					betaNodeZoneLabel:   "eu-west-1a",
+					nodeZoneLabel:       "eu-west-1b",
+					betaNodeRegionLabel: "eu-west-1",
+				},
+			},
+			Spec: v1.NodeSpec{
+				Taints: []v1.Taint{
+					{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+				},
+			},
+			Status: v1.NodeStatus{
+				Addresses: []v1.NodeAddress{
+					{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				},
+				Allocatable: v1.ResourceList{
+					v1.ResourceCPU: resource.MustParse("1900m"),
+				},
+				Conditions: []v1.NodeCondition{
+					{Type: v1.NodeReady, Status: v1.ConditionTrue, Reason: "KubeletReady"},
+				},
+				NodeInfo: v1.NodeSystemInfo{KubeletVersion: "v1.11.2"},
+			},
+		},
+	}
+
+	out := flattenNodes(nodes)
+	if len(out) != 1 {
+		t.Fatalf("expected 1 node, got %d", len(out))
+	}
+	n := out[0].(map[string]interface{})
+	if n["zone"] != "eu-west-1b" {
+		t.Errorf("expected zone from GA label, got %q", n["zone"])
+	}
+	if n["region"] != "eu-west-1" {
+		t.Errorf("expected region from beta label, got %q", n["region"])
+	}
+	if n["kubelet_version"] != "v1.11.2" {
+		t.Errorf("unexpected kubelet version %q", n["kubelet_version"])
+	}
+	expectedTaints := []interface{}{
+		map[string]interface{}{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"},
+	}
+	if !reflect.DeepEqual(n["taints"], expectedTaints) {
+		t.Errorf("expected taints %#v, got %#v", expectedTaints, n["taints"])
+	}
+	expectedAllocatable := map[string]string{"cpu": "1900m"}
+	if !reflect.DeepEqual(n["allocatable"], expectedAllocatable) {
+		t.Errorf("expected allocatable %#v, got %#v", expectedAllocatable, n["allocatable"])
+	}
+}
+
+func TestTotalAllocatable(t *testing.T) {
+	node := func(cpu, memory string) v1.Node {
+		return v1.Node{Status: v1.NodeStatus{Allocatable: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}}}
+	}
+	cases := []struct {
+		Nodes          []v1.Node
+		ExpectedCPU    string
+		ExpectedMemory string
+	}{
+		{[]v1.Node{}, "0", "0"},
+		{[]v1.Node{node("1900m", "3Gi")}, "1900m", "3Gi"},
+		{[]v1.Node{node("1900m", "3Gi"), node("2", "1Gi")}, "3900m", "4Gi"},
+	}
+	for _, tc := range cases {
+		cpu := totalAllocatable(tc.Nodes, v1.ResourceCPU)
+		if cpu.String() != tc.ExpectedCPU {
+			t.Errorf("expected cpu %q, got %q", tc.ExpectedCPU, cpu.String())
+		}
+		memory := totalAllocatable(tc.Nodes, v1.ResourceMemory)
+		if memory.String() != tc.ExpectedMemory {
+			t.Errorf("expected memory %q, got %q", tc.ExpectedMemory, memory.String())
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"fmt"
 	"log"
 	"strconv"
 	"strings"
@@ -32,6 +33,10 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 
 	att["dns_policy"] = in.DNSPolicy
 
+	if len(in.HostAliases) > 0 {
+		att["host_aliases"] = flattenHostAliases(in.HostAliases)
+	}
+
 	att["host_ipc"] = in.HostIPC
 	att["host_network"] = in.HostNetwork
 	att["host_pid"] = in.HostPID
@@ -53,9 +58,21 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if len(in.NodeSelector) > 0 {
 		att["node_selector"] = in.NodeSelector
 	}
+	if in.Priority != nil {
+		att["priority"] = int(*in.Priority)
+	}
+	if in.PriorityClassName != "" {
+		att["priority_class_name"] = in.PriorityClassName
+	}
+	if len(in.ReadinessGates) > 0 {
+		att["readiness_gates"] = flattenReadinessGates(in.ReadinessGates)
+	}
 	if in.RestartPolicy != "" {
 		att["restart_policy"] = in.RestartPolicy
 	}
+	if in.SchedulerName != "" {
+		att["scheduler_name"] = in.SchedulerName
+	}
 
 	if in.SecurityContext != nil {
 		att["security_context"] = flattenPodSecurityContext(in.SecurityContext)
@@ -68,6 +85,10 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 		att["automount_service_account_token"] = *in.AutomountServiceAccountToken
 	}
 
+	if in.ShareProcessNamespace != nil {
+		att["share_process_namespace"] = *in.ShareProcessNamespace
+	}
+
 	if in.Subdomain != "" {
 		att["subdomain"] = in.Subdomain
 	}
@@ -94,6 +115,40 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	return []interface{}{att}, nil
 }
 
+func flattenPodStatus(in v1.PodStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["phase"] = string(in.Phase)
+	att["pod_ip"] = in.PodIP
+	att["host_ip"] = in.HostIP
+	att["qos_class"] = string(in.QOSClass)
+
+	statuses := make([]interface{}, len(in.ContainerStatuses), len(in.ContainerStatuses))
+	for i, cs := range in.ContainerStatuses {
+		m := map[string]interface{}{
+			"name":          cs.Name,
+			"image":         cs.Image,
+			"image_id":      cs.ImageID,
+			"container_id":  cs.ContainerID,
+			"ready":         cs.Ready,
+			"restart_count": int(cs.RestartCount),
+		}
+		switch {
+		case cs.State.Waiting != nil:
+			m["state"] = "waiting"
+			m["reason"] = cs.State.Waiting.Reason
+		case cs.State.Running != nil:
+			m["state"] = "running"
+		case cs.State.Terminated != nil:
+			m["state"] = "terminated"
+			m["reason"] = cs.State.Terminated.Reason
+		}
+		statuses[i] = m
+	}
+	att["container_status"] = statuses
+
+	return []interface{}{att}
+}
+
 func flattenDNSConfig(in *v1.PodDNSConfig) interface{} {
 	if in != nil {
 		att := make(map[string]interface{})
@@ -132,6 +187,10 @@ func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 		att["fs_group"] = *in.FSGroup
 	}
 
+	if in.RunAsGroup != nil {
+		att["run_as_group"] = int(*in.RunAsGroup)
+	}
+
 	if in.RunAsNonRoot != nil {
 		att["run_as_non_root"] = *in.RunAsNonRoot
 	}
@@ -148,6 +207,9 @@ func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	if in.SELinuxOptions != nil {
 		att["se_linux_options"] = flattenSeLinuxOptions(in.SELinuxOptions)
 	}
+	if len(in.Sysctls) > 0 {
+		att["sysctls"] = flattenSysctls(in.Sysctls)
+	}
 
 	if len(att) > 0 {
 		return []interface{}{att}
@@ -155,6 +217,38 @@ func flattenPodSecurityContext(in *v1.PodSecurityContext) []interface{} {
 	return []interface{}{}
 }
 
+func flattenSysctls(in []v1.Sysctl) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"name":  v.Name,
+			"value": v.Value,
+		}
+	}
+	return att
+}
+
+func flattenHostAliases(in []v1.HostAlias) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"ip":        v.IP,
+			"hostnames": v.Hostnames,
+		}
+	}
+	return att
+}
+
+func flattenReadinessGates(in []v1.PodReadinessGate) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"condition_type": string(v.ConditionType),
+		}
+	}
+	return att
+}
+
 func flattenSeLinuxOptions(in *v1.SELinuxOptions) []interface{} {
 	att := make(map[string]interface{})
 	if in.User != "" {
@@ -219,6 +313,9 @@ func flattenVolumes(volumes []v1.Volume) ([]interface{}, error) {
 		if v.PersistentVolumeClaim != nil {
 			obj["persistent_volume_claim"] = flattenPersistentVolumeClaimVolumeSource(v.PersistentVolumeClaim)
 		}
+		if v.Projected != nil {
+			obj["projected"] = flattenProjectedVolumeSource(v.Projected)
+		}
 		if v.Secret != nil {
 			obj["secret"] = flattenSecretVolumeSource(v.Secret)
 		}
@@ -394,6 +491,72 @@ func flattenSecretVolumeSource(in *v1.SecretVolumeSource) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenProjectedVolumeSource(in *v1.ProjectedVolumeSource) []interface{} {
+	att := make(map[string]interface{})
+	if in.DefaultMode != nil {
+		att["default_mode"] = int(*in.DefaultMode)
+	}
+	sources := make([]interface{}, len(in.Sources))
+	for i, v := range in.Sources {
+		m := map[string]interface{}{}
+		if v.ConfigMap != nil {
+			m["config_map"] = flattenProjection(v.ConfigMap.Name, v.ConfigMap.Items, v.ConfigMap.Optional)
+		}
+		if v.DownwardAPI != nil {
+			m["downward_api"] = []interface{}{
+				map[string]interface{}{
+					"items": flattenDownwardAPIVolumeFile(v.DownwardAPI.Items),
+				},
+			}
+		}
+		if v.Secret != nil {
+			m["secret"] = flattenProjection(v.Secret.Name, v.Secret.Items, v.Secret.Optional)
+		}
+		if v.ServiceAccountToken != nil {
+			m["service_account_token"] = flattenServiceAccountTokenProjection(v.ServiceAccountToken)
+		}
+		sources[i] = m
+	}
+	att["sources"] = sources
+	return []interface{}{att}
+}
+
+func flattenProjection(name string, in []v1.KeyToPath, optional *bool) []interface{} {
+	att := make(map[string]interface{})
+	if name != "" {
+		att["name"] = name
+	}
+	if len(in) > 0 {
+		items := make([]interface{}, len(in))
+		for i, v := range in {
+			m := map[string]interface{}{}
+			m["key"] = v.Key
+			if v.Mode != nil {
+				m["mode"] = int(*v.Mode)
+			}
+			m["path"] = v.Path
+			items[i] = m
+		}
+		att["items"] = items
+	}
+	if optional != nil {
+		att["optional"] = *optional
+	}
+	return []interface{}{att}
+}
+
+func flattenServiceAccountTokenProjection(in *v1.ServiceAccountTokenProjection) []interface{} {
+	att := make(map[string]interface{})
+	if in.Audience != "" {
+		att["audience"] = in.Audience
+	}
+	if in.ExpirationSeconds != nil {
+		att["expiration_seconds"] = int(*in.ExpirationSeconds)
+	}
+	att["path"] = in.Path
+	return []interface{}{att}
+}
+
 // Expanders
 
 func expandPodTemplateSpec(template map[string]interface{}) (v1.PodTemplateSpec, error) {
@@ -448,6 +611,10 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.DNSPolicy = v1.DNSPolicy(v)
 	}
 
+	if v, ok := in["host_aliases"].([]interface{}); ok && len(v) > 0 {
+		obj.HostAliases = expandHostAliases(v)
+	}
+
 	if v, ok := in["host_ipc"]; ok {
 		obj.HostIPC = v.(bool)
 	}
@@ -491,10 +658,22 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.NodeSelector = nodeSelectors
 	}
 
+	if v, ok := in["priority_class_name"].(string); ok {
+		obj.PriorityClassName = v
+	}
+
+	if v, ok := in["readiness_gates"].([]interface{}); ok && len(v) > 0 {
+		obj.ReadinessGates = expandReadinessGates(v)
+	}
+
 	if v, ok := in["restart_policy"].(string); ok {
 		obj.RestartPolicy = v1.RestartPolicy(v)
 	}
 
+	if v, ok := in["scheduler_name"].(string); ok {
+		obj.SchedulerName = v
+	}
+
 	if v, ok := in["security_context"].([]interface{}); ok && len(v) > 0 {
 		obj.SecurityContext = expandPodSecurityContext(v)
 	}
@@ -507,6 +686,10 @@ func expandPodSpec(p []interface{}) (v1.PodSpec, error) {
 		obj.AutomountServiceAccountToken = ptrToBool(v.(bool))
 	}
 
+	if v, ok := in["share_process_namespace"].(bool); ok && v {
+		obj.ShareProcessNamespace = ptrToBool(v)
+	}
+
 	if v, ok := in["subdomain"].(string); ok {
 		obj.Subdomain = v
 	}
@@ -582,6 +765,11 @@ func expandPodSecurityContext(l []interface{}) *v1.PodSecurityContext {
 	if v, ok := in["fs_group"].(int); ok {
 		obj.FSGroup = ptrToInt64(int64(v))
 	}
+	// Unset and 0 can't be told apart here, and sending 0 for every pod would
+	// override the primary group of the image
+	if v, ok := in["run_as_group"].(int); ok && v != 0 {
+		obj.RunAsGroup = ptrToInt64(int64(v))
+	}
 	if v, ok := in["run_as_non_root"].(bool); ok {
 		obj.RunAsNonRoot = ptrToBool(v)
 	}
@@ -595,10 +783,48 @@ func expandPodSecurityContext(l []interface{}) *v1.PodSecurityContext {
 	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
 		obj.SELinuxOptions = expandSeLinuxOptions(v)
 	}
+	if v, ok := in["sysctls"].([]interface{}); ok && len(v) > 0 {
+		obj.Sysctls = expandSysctls(v)
+	}
 
 	return obj
 }
 
+func expandSysctls(l []interface{}) []v1.Sysctl {
+	obj := make([]v1.Sysctl, len(l))
+	for i, v := range l {
+		in := v.(map[string]interface{})
+		obj[i] = v1.Sysctl{
+			Name:  in["name"].(string),
+			Value: in["value"].(string),
+		}
+	}
+	return obj
+}
+
+func expandHostAliases(l []interface{}) []v1.HostAlias {
+	obj := make([]v1.HostAlias, len(l))
+	for i, v := range l {
+		in := v.(map[string]interface{})
+		obj[i] = v1.HostAlias{
+			IP:        in["ip"].(string),
+			Hostnames: expandStringSlice(in["hostnames"].([]interface{})),
+		}
+	}
+	return obj
+}
+
+func expandReadinessGates(l []interface{}) []v1.PodReadinessGate {
+	obj := make([]v1.PodReadinessGate, len(l))
+	for i, v := range l {
+		in := v.(map[string]interface{})
+		obj[i] = v1.PodReadinessGate{
+			ConditionType: v1.PodConditionType(in["condition_type"].(string)),
+		}
+	}
+	return obj
+}
+
 func expandSeLinuxOptions(l []interface{}) *v1.SELinuxOptions {
 	if len(l) == 0 || l[0] == nil {
 		return &v1.SELinuxOptions{}
@@ -781,6 +1007,92 @@ func expandSecretVolumeSource(l []interface{}) *v1.SecretVolumeSource {
 	return obj
 }
 
+func expandProjectedVolumeSource(l []interface{}) (*v1.ProjectedVolumeSource, error) {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.ProjectedVolumeSource{}, nil
+	}
+	in := l[0].(map[string]interface{})
+	obj := &v1.ProjectedVolumeSource{
+		DefaultMode: ptrToInt32(int32(in["default_mode"].(int))),
+	}
+
+	if v, ok := in["sources"].([]interface{}); ok && len(v) > 0 {
+		obj.Sources = make([]v1.VolumeProjection, len(v))
+		for i, src := range v {
+			m, ok := src.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if v, ok := m["config_map"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				name, items, optional := expandProjection(v)
+				obj.Sources[i].ConfigMap = &v1.ConfigMapProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: name},
+					Items:                items,
+					Optional:             optional,
+				}
+			}
+			if v, ok := m["downward_api"].([]interface{}); ok && len(v) > 0 {
+				obj.Sources[i].DownwardAPI = &v1.DownwardAPIProjection{}
+				if v[0] != nil {
+					p := v[0].(map[string]interface{})
+					if items, ok := p["items"].([]interface{}); ok && len(items) > 0 {
+						var err error
+						obj.Sources[i].DownwardAPI.Items, err = expandDownwardAPIVolumeFile(items)
+						if err != nil {
+							return obj, err
+						}
+					}
+				}
+			}
+			if v, ok := m["secret"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				name, items, optional := expandProjection(v)
+				obj.Sources[i].Secret = &v1.SecretProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: name},
+					Items:                items,
+					Optional:             optional,
+				}
+			}
+			if v, ok := m["service_account_token"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				obj.Sources[i].ServiceAccountToken = expandServiceAccountTokenProjection(v)
+			}
+		}
+	}
+
+	return obj, nil
+}
+
+func expandProjection(l []interface{}) (string, []v1.KeyToPath, *bool) {
+	in := l[0].(map[string]interface{})
+	var name string
+	var items []v1.KeyToPath
+	var optional *bool
+	if v, ok := in["name"].(string); ok {
+		name = v
+	}
+	if v, ok := in["items"].([]interface{}); ok && len(v) > 0 {
+		items = expandKeyPath(v)
+	}
+	if v, ok := in["optional"].(bool); ok {
+		optional = ptrToBool(v)
+	}
+	return name, items, optional
+}
+
+func expandServiceAccountTokenProjection(l []interface{}) *v1.ServiceAccountTokenProjection {
+	in := l[0].(map[string]interface{})
+	obj := &v1.ServiceAccountTokenProjection{}
+	if v, ok := in["audience"].(string); ok {
+		obj.Audience = v
+	}
+	if v, ok := in["expiration_seconds"].(int); ok && v > 0 {
+		obj.ExpirationSeconds = ptrToInt64(int64(v))
+	}
+	if v, ok := in["path"].(string); ok {
+		obj.Path = v
+	}
+	return obj
+}
+
 func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 	if len(volumes) == 0 {
 		return []v1.Volume{}, nil
@@ -818,6 +1130,13 @@ func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 		if value, ok := m["persistent_volume_claim"].([]interface{}); ok && len(value) > 0 {
 			vl[i].PersistentVolumeClaim = expandPersistentVolumeClaimVolumeSource(value)
 		}
+		if value, ok := m["projected"].([]interface{}); ok && len(value) > 0 {
+			var err error
+			vl[i].Projected, err = expandProjectedVolumeSource(value)
+			if err != nil {
+				return vl, err
+			}
+		}
 		if value, ok := m["secret"].([]interface{}); ok && len(value) > 0 {
 			vl[i].Secret = expandSecretVolumeSource(value)
 		}
@@ -876,6 +1195,30 @@ func expandVolumes(volumes []interface{}) ([]v1.Volume, error) {
 	return vl, nil
 }
 
+// validatePodSpecBlockVolumes checks that volumes backed by a claim in Block
+// mode are consumed through volume_device and that every volume_device
+// references such a volume. blockVolumes maps volume names to whether they are
+// block volumes; volumes missing from the map are not checked.
+func validatePodSpecBlockVolumes(spec v1.PodSpec, blockVolumes map[string]bool) error {
+	containers := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	containers = append(containers, spec.InitContainers...)
+	containers = append(containers, spec.Containers...)
+
+	for _, c := range containers {
+		for _, m := range c.VolumeMounts {
+			if blockVolumes[m.Name] {
+				return fmt.Errorf("container %q: volume %q is a persistent volume claim in Block mode and must be consumed through volume_device instead of volume_mount", c.Name, m.Name)
+			}
+		}
+		for _, dev := range c.VolumeDevices {
+			if isBlock, ok := blockVolumes[dev.Name]; ok && !isBlock {
+				return fmt.Errorf("container %q: volume_device %q must reference a persistent volume claim in Block mode", c.Name, dev.Name)
+			}
+		}
+	}
+	return nil
+}
+
 func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
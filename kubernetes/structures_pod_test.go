@@ -0,0 +1,234 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+)
+
+func TestPodSpecRoundTrip(t *testing.T) {
+	in := v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Name:  "app",
+				Image: "nginx:1.15",
+			},
+		},
+		HostAliases: []v1.HostAlias{
+			{
+				IP:        "10.0.0.1",
+				Hostnames: []string{"foo.local", "bar.local"},
+			},
+		},
+		Priority:          ptrToInt32(1000),
+		PriorityClassName: "high-priority",
+		ReadinessGates: []v1.PodReadinessGate{
+			{ConditionType: v1.PodConditionType("www.example.com/feature-1")},
+		},
+		SchedulerName: "my-scheduler",
+		SecurityContext: &v1.PodSecurityContext{
+			RunAsGroup: ptrToInt64(2000),
+			RunAsUser:  ptrToInt64(1000),
+			Sysctls: []v1.Sysctl{
+				{Name: "kernel.shm_rmid_forced", Value: "0"},
+				{Name: "net.core.somaxconn", Value: "1024"},
+			},
+		},
+		ShareProcessNamespace: ptrToBool(true),
+	}
+
+	flattened, err := flattenPodSpec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Pass the flattened spec through the schema so the expander sees the
+	// same types it receives from Terraform.
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"spec": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: podSpecFields(true),
+			},
+		},
+	}, map[string]interface{}{})
+	if err := d.Set("spec", flattened); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := expandPodSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(out.HostAliases, in.HostAliases) {
+		t.Errorf("host aliases mismatch: expected %#v, got %#v", in.HostAliases, out.HostAliases)
+	}
+	if out.Priority != nil {
+		t.Errorf("priority is populated by the admission controller and must not be sent, got %v", *out.Priority)
+	}
+	if out.PriorityClassName != in.PriorityClassName {
+		t.Errorf("priority class name mismatch: expected %q, got %q", in.PriorityClassName, out.PriorityClassName)
+	}
+	if !reflect.DeepEqual(out.ReadinessGates, in.ReadinessGates) {
+		t.Errorf("readiness gates mismatch: expected %#v, got %#v", in.ReadinessGates, out.ReadinessGates)
+	}
+	if out.SchedulerName != in.SchedulerName {
+		t.Errorf("scheduler name mismatch: expected %q, got %q", in.SchedulerName, out.SchedulerName)
+	}
+	if !reflect.DeepEqual(out.ShareProcessNamespace, in.ShareProcessNamespace) {
+		t.Errorf("share process namespace mismatch: expected %v, got %v", *in.ShareProcessNamespace, out.ShareProcessNamespace)
+	}
+	if !reflect.DeepEqual(out.SecurityContext.RunAsGroup, in.SecurityContext.RunAsGroup) {
+		t.Errorf("run as group mismatch: expected %v, got %v", *in.SecurityContext.RunAsGroup, out.SecurityContext.RunAsGroup)
+	}
+	if !reflect.DeepEqual(out.SecurityContext.Sysctls, in.SecurityContext.Sysctls) {
+		t.Errorf("sysctls mismatch: expected %#v, got %#v", in.SecurityContext.Sysctls, out.SecurityContext.Sysctls)
+	}
+}
+
+func TestExpandPodSecurityContextUnsetGroup(t *testing.T) {
+	out := expandPodSecurityContext([]interface{}{
+		map[string]interface{}{
+			"run_as_group": 0,
+			"sysctls":      []interface{}{},
+		},
+	})
+	if out.RunAsGroup != nil {
+		t.Errorf("expected run_as_group to be left to the runtime, got %d", *out.RunAsGroup)
+	}
+	if out.Sysctls != nil {
+		t.Errorf("expected no sysctls, got %#v", out.Sysctls)
+	}
+}
+
+func TestProjectedVolumeRoundTrip(t *testing.T) {
+	in := []v1.Volume{
+		{
+			Name: "all-in-one",
+			VolumeSource: v1.VolumeSource{
+				Projected: &v1.ProjectedVolumeSource{
+					DefaultMode: ptrToInt32(0440),
+					Sources: []v1.VolumeProjection{
+						{
+							Secret: &v1.SecretProjection{
+								LocalObjectReference: v1.LocalObjectReference{Name: "creds"},
+								Items: []v1.KeyToPath{
+									{Key: "username", Mode: ptrToInt32(0400), Path: "my-group/my-username"},
+								},
+								Optional: ptrToBool(false),
+							},
+						},
+						{
+							ConfigMap: &v1.ConfigMapProjection{
+								LocalObjectReference: v1.LocalObjectReference{Name: "config"},
+								Optional:             ptrToBool(true),
+							},
+						},
+						{
+							DownwardAPI: &v1.DownwardAPIProjection{
+								Items: []v1.DownwardAPIVolumeFile{
+									{
+										Mode: ptrToInt32(0444),
+										Path: "labels",
+										FieldRef: &v1.ObjectFieldSelector{
+											APIVersion: "v1",
+											FieldPath:  "metadata.labels",
+										},
+									},
+								},
+							},
+						},
+						{
+							ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+								Audience:          "vault",
+								ExpirationSeconds: ptrToInt64(7200),
+								Path:              "token",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	flattened, err := flattenVolumes(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"volume": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     volumeSchema(true),
+		},
+	}, map[string]interface{}{})
+	if err := d.Set("volume", flattened); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := expandVolumes(d.Get("volume").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(out[0].Projected, in[0].Projected) {
+		t.Errorf("projected volume mismatch:\nexpected: %#v\ngot: %#v", in[0].Projected, out[0].Projected)
+	}
+}
+
+func TestValidatePodSpecBlockVolumes(t *testing.T) {
+	blockVolumes := map[string]bool{
+		"block": true,
+		"fs":    false,
+	}
+	testCases := []struct {
+		Container v1.Container
+		ExpectErr bool
+	}{
+		{
+			Container: v1.Container{
+				Name:          "device",
+				VolumeDevices: []v1.VolumeDevice{{Name: "block", DevicePath: "/dev/xvda"}},
+				VolumeMounts:  []v1.VolumeMount{{Name: "fs", MountPath: "/data"}},
+			},
+		},
+		{
+			Container: v1.Container{
+				Name:         "mount-block",
+				VolumeMounts: []v1.VolumeMount{{Name: "block", MountPath: "/data"}},
+			},
+			ExpectErr: true,
+		},
+		{
+			Container: v1.Container{
+				Name:          "device-fs",
+				VolumeDevices: []v1.VolumeDevice{{Name: "fs", DevicePath: "/dev/xvda"}},
+			},
+			ExpectErr: true,
+		},
+		{
+			Container: v1.Container{
+				Name:          "unknown",
+				VolumeDevices: []v1.VolumeDevice{{Name: "not-yet-created", DevicePath: "/dev/xvda"}},
+				VolumeMounts:  []v1.VolumeMount{{Name: "not-yet-created", MountPath: "/data"}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Container.Name, func(t *testing.T) {
+			spec := v1.PodSpec{Containers: []v1.Container{tc.Container}}
+			err := validatePodSpecBlockVolumes(spec, blockVolumes)
+			if tc.ExpectErr && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !tc.ExpectErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func expandClusterRoleRule(in []interface{}) []v1.PolicyRule {
@@ -36,6 +37,21 @@ func expandClusterRoleRule(in []interface{}) []v1.PolicyRule {
 	return rules
 }
 
+func expandClusterRoleAggregationRule(in []interface{}) *v1.AggregationRule {
+	if len(in) == 0 || in[0] == nil {
+		return &v1.AggregationRule{}
+	}
+	cfg := in[0].(map[string]interface{})
+	ar := &v1.AggregationRule{}
+	if v, ok := cfg["cluster_role_selectors"].([]interface{}); ok && len(v) > 0 {
+		ar.ClusterRoleSelectors = make([]metav1.LabelSelector, len(v))
+		for i, sel := range v {
+			ar.ClusterRoleSelectors[i] = *expandLabelSelector([]interface{}{sel})
+		}
+	}
+	return ar
+}
+
 func expandRoleRef(in interface{}) v1.RoleRef {
 	obj := v1.RoleRef{}
 
@@ -100,6 +116,24 @@ func flattenClusterRoleRules(in []v1.PolicyRule) []interface{} {
 	return att
 }
 
+func flattenClusterRoleAggregationRule(in *v1.AggregationRule) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	selectors := make([]interface{}, 0, len(in.ClusterRoleSelectors))
+	for _, sel := range in.ClusterRoleSelectors {
+		if s := flattenLabelSelector(&sel); len(s) > 0 {
+			selectors = append(selectors, s[0])
+		} else {
+			selectors = append(selectors, map[string]interface{}{})
+		}
+	}
+	m := make(map[string]interface{})
+	m["cluster_role_selectors"] = selectors
+
+	return []interface{}{m}
+}
+
 func flattenRoleRef(in v1.RoleRef) []interface{} {
 	m := make(map[string]interface{})
 
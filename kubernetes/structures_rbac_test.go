@@ -0,0 +1,33 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClusterRoleAggregationRuleRoundTrip(t *testing.T) {
+	in := &v1.AggregationRule{
+		ClusterRoleSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"rbac.example.com/aggregate-to-monitoring": "true"}},
+			{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpIn, Values: []string{"platform"}},
+				},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesClusterRole().Schema, map[string]interface{}{})
+	if err := d.Set("aggregation_rule", flattenClusterRoleAggregationRule(in)); err != nil {
+		t.Fatal(err)
+	}
+
+	out := expandClusterRoleAggregationRule(d.Get("aggregation_rule").([]interface{}))
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("aggregation rule mismatch:\nexpected: %#v\ngot: %#v", in, out)
+	}
+}
@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func flattenReplicaSetSpec(in appsv1.ReplicaSetSpec, d *schema.ResourceData) ([]interface{}, error) {
+	att := make(map[string]interface{})
+
+	att["min_ready_seconds"] = in.MinReadySeconds
+	if in.Replicas != nil {
+		att["replicas"] = *in.Replicas
+	}
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
+
+	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d, "spec.0.template.0.")
+	podSpec, err := flattenPodSpec(in.Template.Spec)
+	if err != nil {
+		return nil, err
+	}
+	template := make(map[string]interface{})
+	template["metadata"] = templateMetadata
+	template["spec"] = podSpec
+	att["template"] = []interface{}{template}
+
+	return []interface{}{att}, nil
+}
+
+func expandReplicaSetSpec(rs []interface{}) (appsv1.ReplicaSetSpec, error) {
+	obj := appsv1.ReplicaSetSpec{}
+	if len(rs) == 0 || rs[0] == nil {
+		return obj, nil
+	}
+	in := rs[0].(map[string]interface{})
+
+	obj.MinReadySeconds = int32(in["min_ready_seconds"].(int))
+	obj.Replicas = ptrToInt32(int32(in["replicas"].(int)))
+	obj.Selector = expandLabelSelector(in["selector"].([]interface{}))
+
+	for _, v := range in["template"].([]interface{}) {
+		template := v.(map[string]interface{})
+		pts, err := expandPodTemplateSpec(template)
+		if err != nil {
+			return obj, err
+		}
+		obj.Template = pts
+	}
+
+	return obj, nil
+}
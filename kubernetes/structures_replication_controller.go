@@ -23,6 +23,23 @@ func flattenReplicationControllerSpec(in v1.ReplicationControllerSpec) ([]interf
 	return []interface{}{att}, nil
 }
 
+func flattenReplicationControllerStatus(in v1.ReplicationControllerStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["observed_generation"] = int(in.ObservedGeneration)
+	att["available_replicas"] = int(in.AvailableReplicas)
+	att["fully_labeled_replicas"] = int(in.FullyLabeledReplicas)
+	att["ready_replicas"] = int(in.ReadyReplicas)
+	att["replicas"] = int(in.Replicas)
+
+	conditions := make([]interface{}, len(in.Conditions), len(in.Conditions))
+	for i, c := range in.Conditions {
+		conditions[i] = flattenWorkloadCondition(string(c.Type), c.Status, c.Reason, c.Message, c.LastTransitionTime)
+	}
+	att["condition"] = conditions
+
+	return []interface{}{att}
+}
+
 func expandReplicationControllerSpec(rc []interface{}) (v1.ReplicationControllerSpec, error) {
 	obj := v1.ReplicationControllerSpec{}
 	if len(rc) == 0 || rc[0] == nil {
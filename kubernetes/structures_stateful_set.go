@@ -6,7 +6,6 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func flattenStatefulSetSpec(in appsv1.StatefulSetSpec, d *schema.ResourceData) ([]interface{}, error) {
@@ -20,7 +19,9 @@ func flattenStatefulSetSpec(in appsv1.StatefulSetSpec, d *schema.ResourceData) (
 		att["revision_history_limit"] = *in.RevisionHistoryLimit
 	}
 	att["service_name"] = in.ServiceName
-	att["selector"] = in.Selector.MatchLabels
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
 	att["update_strategy"] = flattenStatefulSetUpdateStrategy(in.UpdateStrategy, d)
 
 	templateMetadata := flattenMetadata(in.Template.ObjectMeta, d, "spec.0.template.0.")
@@ -65,6 +66,25 @@ func flattenStatefulSetStrategyRollingUpdate(in *appsv1.RollingUpdateStatefulSet
 	return []interface{}{att}
 }
 
+func flattenStatefulSetStatus(in appsv1.StatefulSetStatus) []interface{} {
+	att := make(map[string]interface{})
+	att["observed_generation"] = int(in.ObservedGeneration)
+	att["current_replicas"] = int(in.CurrentReplicas)
+	att["ready_replicas"] = int(in.ReadyReplicas)
+	att["replicas"] = int(in.Replicas)
+	att["updated_replicas"] = int(in.UpdatedReplicas)
+	att["current_revision"] = in.CurrentRevision
+	att["update_revision"] = in.UpdateRevision
+
+	conditions := make([]interface{}, len(in.Conditions), len(in.Conditions))
+	for i, c := range in.Conditions {
+		conditions[i] = flattenWorkloadCondition(string(c.Type), c.Status, c.Reason, c.Message, c.LastTransitionTime)
+	}
+	att["condition"] = conditions
+
+	return []interface{}{att}
+}
+
 //
 // EXPANDERS
 //
@@ -85,9 +105,7 @@ func expandStatefulSetSpec(statefulSet []interface{}) (appsv1.StatefulSetSpec, e
 	}
 
 	obj.Replicas = ptrToInt32(int32(in["replicas"].(int)))
-	obj.Selector = &metav1.LabelSelector{
-		MatchLabels: expandStringMap(in["selector"].(map[string]interface{})),
-	}
+	obj.Selector = expandLabelSelector(in["selector"].([]interface{}))
 	obj.ServiceName = in["service_name"].(string)
 
 	for _, v := range in["template"].([]interface{}) {
@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	api "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	defaultStorageClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// Flatteners
+
+func flattenTopologySelectorTerms(in []v1.TopologySelectorTerm) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		if len(n.MatchLabelExpressions) > 0 {
+			m["match_label_expressions"] = flattenTopologySelectorLabelRequirements(n.MatchLabelExpressions)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenTopologySelectorLabelRequirements(in []v1.TopologySelectorLabelRequirement) []interface{} {
+	att := make([]interface{}, len(in), len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
+		m["key"] = n.Key
+		m["values"] = newStringSet(schema.HashString, n.Values)
+		att[i] = m
+	}
+	return att
+}
+
+// Expanders
+
+func expandTopologySelectorTerms(t []interface{}) []v1.TopologySelectorTerm {
+	if len(t) == 0 || t[0] == nil {
+		return []v1.TopologySelectorTerm{}
+	}
+	obj := make([]v1.TopologySelectorTerm, len(t), len(t))
+	for i, n := range t {
+		in := n.(map[string]interface{})
+		if v, ok := in["match_label_expressions"].([]interface{}); ok && len(v) > 0 {
+			obj[i].MatchLabelExpressions = expandTopologySelectorLabelRequirements(v)
+		}
+	}
+	return obj
+}
+
+func expandTopologySelectorLabelRequirements(r []interface{}) []v1.TopologySelectorLabelRequirement {
+	if len(r) == 0 || r[0] == nil {
+		return []v1.TopologySelectorLabelRequirement{}
+	}
+	obj := make([]v1.TopologySelectorLabelRequirement, len(r), len(r))
+	for i, n := range r {
+		in := n.(map[string]interface{})
+		obj[i] = v1.TopologySelectorLabelRequirement{
+			Key: in["key"].(string),
+		}
+		if v, ok := in["values"].(*schema.Set); ok && v.Len() > 0 {
+			obj[i].Values = sliceOfString(v.List())
+		}
+	}
+	return obj
+}
+
+// Default storage class
+
+func isDefaultStorageClass(meta metav1.ObjectMeta) bool {
+	return meta.Annotations[defaultStorageClassAnnotation] == "true" ||
+		meta.Annotations[betaDefaultStorageClassAnnotation] == "true"
+}
+
+func setDefaultStorageClassAnnotation(meta *metav1.ObjectMeta, isDefault bool) {
+	if isDefault {
+		if meta.Annotations == nil {
+			meta.Annotations = make(map[string]string)
+		}
+		meta.Annotations[defaultStorageClassAnnotation] = "true"
+		return
+	}
+	if _, ok := meta.Annotations[defaultStorageClassAnnotation]; ok {
+		meta.Annotations[defaultStorageClassAnnotation] = "false"
+	}
+	delete(meta.Annotations, betaDefaultStorageClassAnnotation)
+}
+
+// otherDefaultStorageClasses returns the storage classes other than the named one
+// that are currently annotated as the cluster default.
+func otherDefaultStorageClasses(classes []api.StorageClass, name string) []api.StorageClass {
+	out := make([]api.StorageClass, 0)
+	for _, sc := range classes {
+		if sc.Name != name && isDefaultStorageClass(sc.ObjectMeta) {
+			out = append(out, sc)
+		}
+	}
+	return out
+}
+
+// defaultStorageClassesToDemote returns the storage classes that must lose the
+// default annotation for the named one to become the cluster default. Unless
+// demote is set, an error is returned when there are any so the caller can stop
+// before changing anything.
+func defaultStorageClassesToDemote(conn *kubernetes.Clientset, name string, demote bool) ([]api.StorageClass, error) {
+	list, err := conn.StorageV1().StorageClasses().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list storage classes: %s", err)
+	}
+	others := otherDefaultStorageClasses(list.Items, name)
+	if len(others) > 0 && !demote {
+		return nil, fmt.Errorf("Storage class %q is already the default storage class, "+
+			"set `demote_existing_default` to make %q the default instead", others[0].Name, name)
+	}
+	return others, nil
+}
+
+// demoteDefaultStorageClasses removes the default annotation from the given
+// storage classes. It is only called once the new default is in place, so a
+// failure never leaves the cluster without a default storage class.
+func demoteDefaultStorageClasses(conn *kubernetes.Clientset, classes []api.StorageClass) error {
+	for _, sc := range classes {
+		setDefaultStorageClassAnnotation(&sc.ObjectMeta, false)
+		log.Printf("[INFO] Demoting default storage class %q", sc.Name)
+		_, err := conn.StorageV1().StorageClasses().Update(&sc)
+		if err != nil {
+			return fmt.Errorf("Failed to demote default storage class %q: %s", sc.Name, err)
+		}
+	}
+	return nil
+}
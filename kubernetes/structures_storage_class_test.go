@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"k8s.io/api/core/v1"
+	api "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTopologySelectorTermsRoundTrip(t *testing.T) {
+	in := []v1.TopologySelectorTerm{
+		{
+			MatchLabelExpressions: []v1.TopologySelectorLabelRequirement{
+				{Key: "failure-domain.beta.kubernetes.io/zone", Values: []string{"us-west1-a"}},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesStorageClass().Schema, map[string]interface{}{})
+	if err := d.Set("allowed_topologies", flattenTopologySelectorTerms(in)); err != nil {
+		t.Fatal(err)
+	}
+
+	out := expandTopologySelectorTerms(d.Get("allowed_topologies").([]interface{}))
+	if !reflect.DeepEqual(out, in) {
+		t.Fatalf("allowed topologies mismatch:\nexpected: %#v\ngot: %#v", in, out)
+	}
+}
+
+func TestOtherDefaultStorageClasses(t *testing.T) {
+	classes := []api.StorageClass{
+		{ObjectMeta: metav1.ObjectMeta{Name: "standard", Annotations: map[string]string{defaultStorageClassAnnotation: "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "legacy", Annotations: map[string]string{betaDefaultStorageClassAnnotation: "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "demoted", Annotations: map[string]string{defaultStorageClassAnnotation: "false"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "plain"}},
+	}
+
+	testCases := []struct {
+		Name     string
+		Expected []string
+	}{
+		{"new", []string{"standard", "legacy"}},
+		{"standard", []string{"legacy"}},
+		{"legacy", []string{"standard"}},
+	}
+	for _, tc := range testCases {
+		names := make([]string, 0)
+		for _, sc := range otherDefaultStorageClasses(classes, tc.Name) {
+			names = append(names, sc.Name)
+		}
+		if !reflect.DeepEqual(names, tc.Expected) {
+			t.Errorf("%s: expected %v, got %v", tc.Name, tc.Expected, names)
+		}
+	}
+}
+
+func TestSetDefaultStorageClassAnnotation(t *testing.T) {
+	meta := metav1.ObjectMeta{}
+	setDefaultStorageClassAnnotation(&meta, true)
+	if !isDefaultStorageClass(meta) {
+		t.Fatalf("expected storage class to be default, annotations: %#v", meta.Annotations)
+	}
+
+	meta.Annotations[betaDefaultStorageClassAnnotation] = "true"
+	setDefaultStorageClassAnnotation(&meta, false)
+	if isDefaultStorageClass(meta) {
+		t.Fatalf("expected storage class not to be default, annotations: %#v", meta.Annotations)
+	}
+	if meta.Annotations[defaultStorageClassAnnotation] != "false" {
+		t.Fatalf("expected default annotation to be demoted, annotations: %#v", meta.Annotations)
+	}
+}
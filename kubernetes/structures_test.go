@@ -3,6 +3,9 @@ package kubernetes
 import (
 	"fmt"
 	"testing"
+
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestIsInternalKey(t *testing.T) {
@@ -30,3 +33,37 @@ func TestIsInternalKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRemainingResourceQuota(t *testing.T) {
+	hard := api.ResourceList{
+		"cpu":     resource.MustParse("2"),
+		"memory":  resource.MustParse("2Gi"),
+		"pods":    resource.MustParse("4"),
+		"secrets": resource.MustParse("10"),
+	}
+	used := api.ResourceList{
+		"cpu":    resource.MustParse("500m"),
+		"memory": resource.MustParse("512Mi"),
+		"pods":   resource.MustParse("6"),
+	}
+	expected := map[string]string{
+		"cpu":     "1500m",
+		"memory":  "1536Mi",
+		"pods":    "-2",
+		"secrets": "10",
+	}
+
+	out := remainingResourceQuota(hard, used)
+	if len(out) != len(expected) {
+		t.Fatalf("Expected %d resources, got %d: %#v", len(expected), len(out), out)
+	}
+	for k, v := range expected {
+		q, ok := out[api.ResourceName(k)]
+		if !ok {
+			t.Fatalf("Expected %q to be present", k)
+		}
+		if q.Cmp(resource.MustParse(v)) != 0 {
+			t.Fatalf("Expected remaining %s to be %s, got %s", k, v, q.String())
+		}
+	}
+}
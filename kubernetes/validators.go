@@ -142,6 +142,22 @@ func validatePositiveInteger(value interface{}, key string) (ws []string, es []e
 	return
 }
 
+func validateNonNegativeInteger(value interface{}, key string) (ws []string, es []error) {
+	v := value.(int)
+	if v < 0 {
+		es = append(es, fmt.Errorf("%s must be greater than or equal to 0", key))
+	}
+	return
+}
+
+func validateProjectedTokenExpiration(value interface{}, key string) (ws []string, es []error) {
+	v := value.(int)
+	if v < 600 {
+		es = append(es, fmt.Errorf("%s must be at least 600 seconds", key))
+	}
+	return
+}
+
 func validateDNSPolicy(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 	if v != "ClusterFirst" && v != "Default" {
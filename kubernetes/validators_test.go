@@ -25,3 +25,16 @@ func TestValidateModeBits(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateProjectedTokenExpiration(t *testing.T) {
+	for _, v := range []int{600, 3600, 86400} {
+		if _, es := validateProjectedTokenExpiration(v, "expiration_seconds"); len(es) > 0 {
+			t.Fatalf("Expected %d to be valid: %#v", v, es)
+		}
+	}
+	for _, v := range []int{-1, 0, 599} {
+		if _, es := validateProjectedTokenExpiration(v, "expiration_seconds"); len(es) == 0 {
+			t.Fatalf("Expected %d to be invalid", v)
+		}
+	}
+}